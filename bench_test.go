@@ -0,0 +1,153 @@
+package functionalgo
+
+import "testing"
+
+const benchSize = 1_000_000
+
+func benchInts() []int {
+	src := make([]int, benchSize)
+	for i := range src {
+		src[i] = i
+	}
+	return src
+}
+
+var (
+	sinkInt   int
+	sinkBool  bool
+	sinkSlice []int
+)
+
+func BenchmarkFoldl(b *testing.B) {
+	src := benchInts()
+	b.Run("Foldl", func(b *testing.B) {
+		for b.Loop() {
+			sinkInt = Foldl(func(acc, x int) int { return acc + x }, 0, src)
+		}
+	})
+	b.Run("loop", func(b *testing.B) {
+		for b.Loop() {
+			acc := 0
+			for _, x := range src {
+				acc += x
+			}
+			sinkInt = acc
+		}
+	})
+}
+
+func BenchmarkFoldr(b *testing.B) {
+	src := benchInts()
+	b.Run("Foldr", func(b *testing.B) {
+		for b.Loop() {
+			sinkInt = Foldr(func(x, acc int) int { return acc + x }, 0, src)
+		}
+	})
+	b.Run("loop", func(b *testing.B) {
+		for b.Loop() {
+			acc := 0
+			for i := len(src) - 1; i >= 0; i-- {
+				acc += src[i]
+			}
+			sinkInt = acc
+		}
+	})
+}
+
+func BenchmarkMap(b *testing.B) {
+	src := benchInts()
+	b.Run("Map", func(b *testing.B) {
+		for b.Loop() {
+			sinkSlice = Map(func(x int) int { return x * 2 }, src)
+		}
+	})
+	b.Run("loop", func(b *testing.B) {
+		for b.Loop() {
+			result := make([]int, 0, len(src))
+			for _, x := range src {
+				result = append(result, x*2)
+			}
+			sinkSlice = result
+		}
+	})
+}
+
+func BenchmarkFilter(b *testing.B) {
+	src := benchInts()
+	b.Run("Filter", func(b *testing.B) {
+		for b.Loop() {
+			sinkSlice = Filter(func(x int) bool { return x%2 == 0 }, src)
+		}
+	})
+	b.Run("loop", func(b *testing.B) {
+		for b.Loop() {
+			result := []int{}
+			for _, x := range src {
+				if x%2 == 0 {
+					result = append(result, x)
+				}
+			}
+			sinkSlice = result
+		}
+	})
+}
+
+func BenchmarkZipWith(b *testing.B) {
+	src := benchInts()
+	b.Run("ZipWith", func(b *testing.B) {
+		for b.Loop() {
+			sinkSlice = ZipWith(func(x, y int) int { return x + y }, src, src)
+		}
+	})
+	b.Run("loop", func(b *testing.B) {
+		for b.Loop() {
+			result := make([]int, len(src))
+			for i := range src {
+				result[i] = src[i] + src[i]
+			}
+			sinkSlice = result
+		}
+	})
+}
+
+func BenchmarkAny(b *testing.B) {
+	src := benchInts()
+	b.Run("Any", func(b *testing.B) {
+		for b.Loop() {
+			sinkBool = Any(func(x int) bool { return x < 0 }, src)
+		}
+	})
+	b.Run("loop", func(b *testing.B) {
+		for b.Loop() {
+			found := false
+			for _, x := range src {
+				if x < 0 {
+					found = true
+					break
+				}
+			}
+			sinkBool = found
+		}
+	})
+}
+
+func BenchmarkAll(b *testing.B) {
+	src := benchInts()
+	b.Run("All", func(b *testing.B) {
+		for b.Loop() {
+			sinkBool = All(func(x int) bool { return x >= 0 }, src)
+		}
+	})
+	b.Run("loop", func(b *testing.B) {
+		for b.Loop() {
+			ok := true
+			for _, x := range src {
+				if x < 0 {
+					ok = false
+					break
+				}
+			}
+			sinkBool = ok
+		}
+	})
+}
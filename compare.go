@@ -1,5 +1,11 @@
 package functionalgo
 
+import (
+	"cmp"
+	"reflect"
+	"unsafe"
+)
+
 type ComparisonResult int
 
 const (
@@ -21,166 +27,168 @@ func (c ComparisonResult) String() string {
 	}
 }
 
-func Compare[T any](a, b T) ComparisonResult {
-	switch any(a).(type) {
-	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
-		va := any(a)
-		vb := any(b)
-
-		if ia, ok := va.(int); ok {
-			if ib, ok := vb.(int); ok {
-				if ia < ib {
-					return LT
-				} else if ia > ib {
-					return GT
-				}
-				return EQ
-			}
-		}
+func (c ComparisonResult) Succ() ComparisonResult {
+	if c >= GT {
+		panic("Succ: GT is the maximum bound")
+	}
+	return c + 1
+}
 
-		if ia, ok := va.(int8); ok {
-			if ib, ok := vb.(int8); ok {
-				if ia < ib {
-					return LT
-				} else if ia > ib {
-					return GT
-				}
-				return EQ
-			}
-		}
+func (c ComparisonResult) Pred() ComparisonResult {
+	if c <= LT {
+		panic("Pred: LT is the minimum bound")
+	}
+	return c - 1
+}
 
-		if ia, ok := va.(int16); ok {
-			if ib, ok := vb.(int16); ok {
-				if ia < ib {
-					return LT
-				} else if ia > ib {
-					return GT
-				}
-				return EQ
-			}
-		}
+func (ComparisonResult) MinBound() ComparisonResult { return LT }
+func (ComparisonResult) MaxBound() ComparisonResult { return GT }
 
-		if ia, ok := va.(int32); ok {
-			if ib, ok := vb.(int32); ok {
-				if ia < ib {
-					return LT
-				} else if ia > ib {
-					return GT
-				}
-				return EQ
-			}
-		}
+// Comparer is implemented by types that define their own ordering, such as
+// the tuple types. Compare uses it before any built-in rule.
+type Comparer[T any] interface {
+	CompareTo(other T) ComparisonResult
+}
 
-		if ia, ok := va.(int64); ok {
-			if ib, ok := vb.(int64); ok {
-				if ia < ib {
-					return LT
-				} else if ia > ib {
-					return GT
-				}
-				return EQ
-			}
+// Compare orders two values of the same type. Numbers, strings and booleans
+// (and named types built on them) use their natural order, types registered
+// with RegisterCompare or implementing Comparer use their own, and everything
+// else is compared structurally: structs field by field, arrays and slices
+// lexicographically, maps by sorted keys, pointers by pointee with nil first.
+// It panics on func values.
+func Compare[T any](a, b T) ComparisonResult {
+	if !hasCustomComparers.Load() {
+		if c, ok := compareOrdered(a, b); ok {
+			return c
 		}
+	}
+	return compareReflect(a, b)
+}
 
-		if ua, ok := va.(uint); ok {
-			if ub, ok := vb.(uint); ok {
-				if ua < ub {
-					return LT
-				} else if ua > ub {
-					return GT
-				}
-				return EQ
-			}
-		}
+// compareReflect is the slow path of Compare, kept separate so that only it
+// moves a and b to the heap.
+func compareReflect[T any](a, b T) ComparisonResult {
+	if c, ok := any(a).(Comparer[T]); ok {
+		return c.CompareTo(b)
+	}
+	return deepCompare(reflect.ValueOf(&a).Elem(), reflect.ValueOf(&b).Elem(), map[visit]bool{})
+}
 
-		if ua, ok := va.(uint8); ok {
-			if ub, ok := vb.(uint8); ok {
-				if ua < ub {
-					return LT
-				} else if ua > ub {
-					return GT
-				}
-				return EQ
-			}
-		}
+// Ord is satisfied by every type with a built-in ordering, including named
+// types such as `type Celsius float64`.
+type Ord interface {
+	cmp.Ordered
+}
 
-		if ua, ok := va.(uint16); ok {
-			if ub, ok := vb.(uint16); ok {
-				if ua < ub {
-					return LT
-				} else if ua > ub {
-					return GT
-				}
-				return EQ
-			}
-		}
+// CompareOrd is the compile-time checked counterpart of Compare. NaN sorts
+// before every other float and equals itself.
+func CompareOrd[T Ord](a, b T) ComparisonResult {
+	return ComparisonResult(cmp.Compare(a, b))
+}
 
-		if ua, ok := va.(uint32); ok {
-			if ub, ok := vb.(uint32); ok {
-				if ua < ub {
-					return LT
-				} else if ua > ub {
-					return GT
-				}
-				return EQ
-			}
-		}
+// compareOrdered compares values of a type with a built-in ordering, named or
+// not, without boxing them. It declines types that implement Comparer.
+func compareOrdered[T any](a, b T) (ComparisonResult, bool) {
+	var zero T
+	if _, ok := any(zero).(Comparer[T]); ok {
+		return EQ, false
+	}
+	pa, pb := unsafe.Pointer(&a), unsafe.Pointer(&b)
+	switch reflect.TypeFor[T]().Kind() {
+	case reflect.Int:
+		return CompareOrd(*(*int)(pa), *(*int)(pb)), true
+	case reflect.Int8:
+		return CompareOrd(*(*int8)(pa), *(*int8)(pb)), true
+	case reflect.Int16:
+		return CompareOrd(*(*int16)(pa), *(*int16)(pb)), true
+	case reflect.Int32:
+		return CompareOrd(*(*int32)(pa), *(*int32)(pb)), true
+	case reflect.Int64:
+		return CompareOrd(*(*int64)(pa), *(*int64)(pb)), true
+	case reflect.Uint:
+		return CompareOrd(*(*uint)(pa), *(*uint)(pb)), true
+	case reflect.Uint8:
+		return CompareOrd(*(*uint8)(pa), *(*uint8)(pb)), true
+	case reflect.Uint16:
+		return CompareOrd(*(*uint16)(pa), *(*uint16)(pb)), true
+	case reflect.Uint32:
+		return CompareOrd(*(*uint32)(pa), *(*uint32)(pb)), true
+	case reflect.Uint64:
+		return CompareOrd(*(*uint64)(pa), *(*uint64)(pb)), true
+	case reflect.Uintptr:
+		return CompareOrd(*(*uintptr)(pa), *(*uintptr)(pb)), true
+	case reflect.Float32:
+		return CompareOrd(*(*float32)(pa), *(*float32)(pb)), true
+	case reflect.Float64:
+		return CompareOrd(*(*float64)(pa), *(*float64)(pb)), true
+	case reflect.String:
+		return CompareOrd(*(*string)(pa), *(*string)(pb)), true
+	}
+	return EQ, false
+}
 
-		if ua, ok := va.(uint64); ok {
-			if ub, ok := vb.(uint64); ok {
-				if ua < ub {
-					return LT
-				} else if ua > ub {
-					return GT
-				}
-				return EQ
-			}
-		}
+func compareBool(a, b bool) ComparisonResult {
+	switch {
+	case a == b:
+		return EQ
+	case b:
+		return LT
+	default:
+		return GT
+	}
+}
 
-		if fa, ok := va.(float32); ok {
-			if fb, ok := vb.(float32); ok {
-				if fa < fb {
-					return LT
-				} else if fa > fb {
-					return GT
-				}
-				return EQ
-			}
-		}
+// Ordering is a comparator. Compare[T] and CompareOrd[T] are both Orderings.
+type Ordering[T any] func(a, b T) ComparisonResult
+
+func Comparing[T any, K Ord](key func(T) K) Ordering[T] {
+	return func(a, b T) ComparisonResult {
+		return CompareOrd(key(a), key(b))
+	}
+}
 
-		if fa, ok := va.(float64); ok {
-			if fb, ok := vb.(float64); ok {
-				if fa < fb {
-					return LT
-				} else if fa > fb {
-					return GT
-				}
-				return EQ
-			}
+func (o Ordering[T]) ThenComparing(next Ordering[T]) Ordering[T] {
+	return func(a, b T) ComparisonResult {
+		if c := o(a, b); c != EQ {
+			return c
 		}
+		return next(a, b)
+	}
+}
+
+func (o Ordering[T]) Reversed() Ordering[T] {
+	return func(a, b T) ComparisonResult {
+		return o(b, a)
+	}
+}
 
-	case string:
-		sa := any(a).(string)
-		sb := any(b).(string)
-		if sa < sb {
+// NilsFirst lifts an Ordering to pointers, ordering nil before any value.
+func NilsFirst[T any](o Ordering[T]) Ordering[*T] {
+	return func(a, b *T) ComparisonResult {
+		switch {
+		case a == nil && b == nil:
+			return EQ
+		case a == nil:
 			return LT
-		} else if sa > sb {
+		case b == nil:
 			return GT
+		default:
+			return o(*a, *b)
 		}
-		return EQ
+	}
+}
 
-	case bool:
-		ba := any(a).(bool)
-		bb := any(b).(bool)
-		if !ba && bb {
-			return LT
-		} else if ba && !bb {
+func NilsLast[T any](o Ordering[T]) Ordering[*T] {
+	return func(a, b *T) ComparisonResult {
+		switch {
+		case a == nil && b == nil:
+			return EQ
+		case a == nil:
 			return GT
+		case b == nil:
+			return LT
+		default:
+			return o(*a, *b)
 		}
-		return EQ
-	}
-	if any(a) == any(b) {
-		return EQ
 	}
-	return GT
 }
@@ -0,0 +1,174 @@
+package functionalgo
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+type celsius float64
+
+type priority uint8
+
+// rank orders higher ranks first through Comparer.
+type rank int
+
+func (r rank) CompareTo(other rank) ComparisonResult {
+	return CompareOrd(other, r)
+}
+
+func TestCompareNamedTypes(t *testing.T) {
+	t.Run("named float", func(t *testing.T) {
+		if Compare(celsius(-3), celsius(20)) != LT {
+			t.Errorf("Expected Compare(-3°C, 20°C) to be LT")
+		}
+		if Compare(celsius(20), celsius(20)) != EQ {
+			t.Errorf("Expected Compare(20°C, 20°C) to be EQ")
+		}
+	})
+
+	t.Run("named unsigned", func(t *testing.T) {
+		if Compare(priority(9), priority(2)) != GT {
+			t.Errorf("Expected Compare(9, 2) to be GT")
+		}
+	})
+
+	t.Run("comparison result itself", func(t *testing.T) {
+		if Compare(LT, GT) != LT {
+			t.Errorf("Expected Compare(LT, GT) to be LT")
+		}
+	})
+
+	t.Run("interface values of different dynamic types", func(t *testing.T) {
+		if Compare[any](1, "a") == EQ {
+			t.Errorf("Expected values of different types not to be EQ")
+		}
+	})
+
+	t.Run("ordered kinds are compared without allocating", func(t *testing.T) {
+		allocs := testing.AllocsPerRun(100, func() {
+			Compare(int32(1), int32(1000))
+			Compare(celsius(-3), celsius(20))
+			Compare(priority(9), priority(2))
+			Compare(uint64(1<<40), uint64(1<<41))
+			Compare("a", "b")
+		})
+		if allocs != 0 {
+			t.Errorf("Expected no allocations, got %v", allocs)
+		}
+		if Compare(int16(-5), int16(3)) != LT || Compare(float32(2.5), float32(-1)) != GT {
+			t.Errorf("Expected the built-in order for int16 and float32")
+		}
+	})
+
+	t.Run("named ordered type with a Comparer", func(t *testing.T) {
+		if Compare(rank(1), rank(2)) != GT {
+			t.Errorf("Expected CompareTo to take precedence over the built-in order")
+		}
+	})
+
+	t.Run("maximum of named type", func(t *testing.T) {
+		if result := Maximum([]celsius{3, 41.5, -2}); result != 41.5 {
+			t.Errorf("Expected 41.5, got %v", result)
+		}
+	})
+}
+
+func TestCompareOrd(t *testing.T) {
+	if CompareOrd(1, 2) != LT || CompareOrd("b", "a") != GT || CompareOrd(celsius(1), celsius(1)) != EQ {
+		t.Errorf("Expected CompareOrd to follow the built-in ordering")
+	}
+	if CompareOrd(math.NaN(), 0) != LT {
+		t.Errorf("Expected NaN to sort before numbers")
+	}
+}
+
+type employee struct {
+	name string
+	dept string
+	age  int
+}
+
+func TestOrdering(t *testing.T) {
+	staff := []employee{
+		{"carol", "ops", 41},
+		{"alice", "dev", 30},
+		{"bob", "dev", 25},
+		{"dave", "ops", 41},
+	}
+	byDept := Comparing(func(e employee) string { return e.dept })
+	byAge := Comparing(func(e employee) int { return e.age })
+
+	t.Run("comparing a key", func(t *testing.T) {
+		if byAge(staff[0], staff[1]) != GT {
+			t.Errorf("Expected carol to be older than alice")
+		}
+	})
+
+	t.Run("then comparing", func(t *testing.T) {
+		result := Map(func(e employee) string { return e.name }, SortBy(byDept.ThenComparing(byAge), staff))
+		expected := []string{"bob", "alice", "carol", "dave"}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("reversed", func(t *testing.T) {
+		result := Map(func(e employee) string { return e.name }, SortBy(byAge.Reversed(), staff))
+		expected := []string{"carol", "dave", "alice", "bob"}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("compare functions are orderings", func(t *testing.T) {
+		result := SortBy(Ordering[int](CompareOrd[int]).Reversed(), []int{1, 3, 2})
+		if !reflect.DeepEqual(result, []int{3, 2, 1}) {
+			t.Errorf("Expected [3 2 1], got %v", result)
+		}
+	})
+}
+
+func TestNilsFirst(t *testing.T) {
+	one, two := 1, 2
+	src := []*int{&two, nil, &one}
+
+	first := SortBy(NilsFirst(Ordering[int](CompareOrd[int])), src)
+	if first[0] != nil || *first[1] != 1 || *first[2] != 2 {
+		t.Errorf("Expected [nil 1 2], got %v", first)
+	}
+
+	last := SortBy(NilsLast(Ordering[int](CompareOrd[int])), src)
+	if *last[0] != 1 || *last[1] != 2 || last[2] != nil {
+		t.Errorf("Expected [1 2 nil], got %v", last)
+	}
+}
+
+func TestMaximumByMinimumBy(t *testing.T) {
+	words := []string{"go", "haskell", "ml", "clojure"}
+	byLen := Comparing(func(s string) int { return len(s) })
+	if result := MaximumBy(byLen, words); result != "clojure" {
+		t.Errorf("Expected clojure, got %v", result)
+	}
+	if result := MinimumBy(byLen, words); result != "go" {
+		t.Errorf("Expected go, got %v", result)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected MaximumBy of empty slice to panic")
+		}
+	}()
+	MaximumBy(byLen, []string{})
+}
+
+func TestSortBy(t *testing.T) {
+	src := []int{3, 1, 2}
+	result := SortBy(Compare[int], src)
+	if !reflect.DeepEqual(result, []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", result)
+	}
+	if !reflect.DeepEqual(src, []int{3, 1, 2}) {
+		t.Errorf("Expected input to be left untouched, got %v", src)
+	}
+}
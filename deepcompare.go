@@ -0,0 +1,273 @@
+package functionalgo
+
+import (
+	"bytes"
+	"cmp"
+	"math/big"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unsafe"
+)
+
+var (
+	customComparers    sync.Map // reflect.Type -> func(a, b any) ComparisonResult
+	hasCustomComparers atomic.Bool
+	structOrders       sync.Map // reflect.Type -> []int
+)
+
+var (
+	timeType      = reflect.TypeFor[time.Time]()
+	bigIntType    = reflect.TypeFor[big.Int]()
+	bigIntPtrType = reflect.TypeFor[*big.Int]()
+)
+
+// RegisterCompare makes Compare use ord for every value of type T, including
+// values nested inside structs, slices and maps. A later registration for the
+// same type replaces the earlier one.
+func RegisterCompare[T any](ord Ordering[T]) {
+	customComparers.Store(reflect.TypeFor[T](), func(a, b any) ComparisonResult {
+		return ord(a.(T), b.(T))
+	})
+	hasCustomComparers.Store(true)
+}
+
+type visit struct {
+	a, b uintptr
+	typ  reflect.Type
+}
+
+// deepCompare orders two values of the same static type structurally:
+// structs field by field, arrays and slices elementwise, maps by sorted keys,
+// pointers by pointee and interfaces by dynamic value.
+func deepCompare(a, b reflect.Value, visited map[visit]bool) ComparisonResult {
+	if !a.IsValid() || !b.IsValid() {
+		return compareBool(a.IsValid(), b.IsValid())
+	}
+	if a.Type() != b.Type() {
+		return CompareOrd(a.Type().String(), b.Type().String())
+	}
+
+	if c, ok := compareSpecial(a, b); ok {
+		return c
+	}
+
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return CompareOrd(a.Int(), b.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return CompareOrd(a.Uint(), b.Uint())
+	case reflect.Float32, reflect.Float64:
+		return CompareOrd(a.Float(), b.Float())
+	case reflect.Complex64, reflect.Complex128:
+		ca, cb := a.Complex(), b.Complex()
+		if c := CompareOrd(real(ca), real(cb)); c != EQ {
+			return c
+		}
+		return CompareOrd(imag(ca), imag(cb))
+	case reflect.String:
+		return CompareOrd(a.String(), b.String())
+	case reflect.Bool:
+		return compareBool(a.Bool(), b.Bool())
+	case reflect.Struct:
+		if !a.CanAddr() {
+			a, b = addressable(a), addressable(b)
+		}
+		for _, i := range structOrder(a.Type()) {
+			if c := deepCompare(a.Field(i), b.Field(i), visited); c != EQ {
+				return c
+			}
+		}
+		return EQ
+	case reflect.Array:
+		return compareElements(a, b, visited)
+	case reflect.Slice:
+		if a.Type().Elem().Kind() == reflect.Uint8 {
+			return ComparisonResult(bytes.Compare(a.Bytes(), b.Bytes()))
+		}
+		if a.Len() > 0 && b.Len() > 0 && a.UnsafePointer() == b.UnsafePointer() && a.Len() == b.Len() {
+			return EQ
+		}
+		return compareElements(a, b, visited)
+	case reflect.Map:
+		if !a.CanInterface() {
+			a, b = addressable(a), addressable(b)
+		}
+		return compareMaps(a, b, visited)
+	case reflect.Pointer:
+		if a.IsNil() || b.IsNil() {
+			return compareBool(!a.IsNil(), !b.IsNil())
+		}
+		if a.Pointer() == b.Pointer() {
+			return EQ
+		}
+		v := visit{a: a.Pointer(), b: b.Pointer(), typ: a.Type()}
+		if visited[v] {
+			return EQ
+		}
+		visited[v] = true
+		return deepCompare(a.Elem(), b.Elem(), visited)
+	case reflect.Interface:
+		if !a.CanInterface() {
+			a, b = addressable(a), addressable(b)
+		}
+		return deepCompare(a.Elem(), b.Elem(), visited)
+	case reflect.Chan, reflect.UnsafePointer:
+		return CompareOrd(a.Pointer(), b.Pointer())
+	case reflect.Func:
+		if a.IsNil() && b.IsNil() {
+			return EQ
+		}
+		panic("cannot compare func values")
+	}
+	panic("cannot compare values of kind " + a.Kind().String())
+}
+
+// compareSpecial handles registered orderings, Comparer implementations and
+// standard library types whose fields do not reflect their ordering.
+func compareSpecial(a, b reflect.Value) (ComparisonResult, bool) {
+	t := a.Type()
+	var custom any
+	if hasCustomComparers.Load() {
+		custom, _ = customComparers.Load(t)
+	}
+	if custom == nil && t != timeType && t != bigIntType && t != bigIntPtrType && !hasCompareTo(t) {
+		return EQ, false
+	}
+
+	a, b = addressable(a), addressable(b)
+	ia, ib := a.Interface(), b.Interface()
+
+	switch {
+	case custom != nil:
+		return custom.(func(a, b any) ComparisonResult)(ia, ib), true
+	case t == timeType:
+		return ComparisonResult(ia.(time.Time).Compare(ib.(time.Time))), true
+	case t == bigIntType:
+		return ComparisonResult(a.Addr().Interface().(*big.Int).Cmp(b.Addr().Interface().(*big.Int))), true
+	case t == bigIntPtrType:
+		pa, pb := ia.(*big.Int), ib.(*big.Int)
+		if pa == nil || pb == nil {
+			return compareBool(pa != nil, pb != nil), true
+		}
+		return ComparisonResult(pa.Cmp(pb)), true
+	default:
+		out := reflect.ValueOf(ia).MethodByName("CompareTo").Call([]reflect.Value{reflect.ValueOf(ib)})
+		return out[0].Interface().(ComparisonResult), true
+	}
+}
+
+// hasCompareTo reports whether t implements Comparer[t].
+func hasCompareTo(t reflect.Type) bool {
+	if t.Kind() == reflect.Interface || t.NumMethod() == 0 {
+		return false
+	}
+	m, ok := t.MethodByName("CompareTo")
+	return ok && m.Type.NumIn() == 2 && m.Type.In(1) == t &&
+		m.Type.NumOut() == 1 && m.Type.Out(0) == reflect.TypeFor[ComparisonResult]()
+}
+
+// addressable returns v as an addressable value that is not read-only, so
+// that Addr and Interface work on it and its fields. Values reached through
+// unexported fields are re-wrapped in place; other values that are not
+// addressable, such as map entries and interface contents, are copied.
+// deepCompare keeps every struct addressable and every map and interface
+// writable before descending into it, so a value is never both.
+func addressable(v reflect.Value) reflect.Value {
+	if v.CanAddr() {
+		if v.CanInterface() {
+			return v
+		}
+		return reflect.NewAt(v.Type(), unsafe.Pointer(v.UnsafeAddr())).Elem()
+	}
+	c := reflect.New(v.Type()).Elem()
+	c.Set(v)
+	return c
+}
+
+func compareElements(a, b reflect.Value, visited map[visit]bool) ComparisonResult {
+	n := min(a.Len(), b.Len())
+	for i := range n {
+		if c := deepCompare(a.Index(i), b.Index(i), visited); c != EQ {
+			return c
+		}
+	}
+	return CompareOrd(a.Len(), b.Len())
+}
+
+func compareMaps(a, b reflect.Value, visited map[visit]bool) ComparisonResult {
+	if a.IsNil() || b.IsNil() {
+		if a.IsNil() && b.IsNil() {
+			return EQ
+		}
+		return CompareOrd(a.Len(), b.Len())
+	}
+	keysA, keysB := sortedKeys(a, visited), sortedKeys(b, visited)
+	n := min(len(keysA), len(keysB))
+	for i := range n {
+		if c := deepCompare(keysA[i], keysB[i], visited); c != EQ {
+			return c
+		}
+		if c := deepCompare(a.MapIndex(keysA[i]), b.MapIndex(keysB[i]), visited); c != EQ {
+			return c
+		}
+	}
+	return CompareOrd(len(keysA), len(keysB))
+}
+
+func sortedKeys(m reflect.Value, visited map[visit]bool) []reflect.Value {
+	keys := m.MapKeys()
+	slices.SortFunc(keys, func(x, y reflect.Value) int {
+		return int(deepCompare(x, y, visited))
+	})
+	return keys
+}
+
+// structOrder returns the indices of the fields that take part in comparing
+// values of t, honouring the fg struct tag:
+//
+//	fg:"-"        the field is ignored
+//	fg:"order"    the field is compared before untagged fields
+//	fg:"order=N"  as above, with tagged fields compared by ascending N
+func structOrder(t reflect.Type) []int {
+	if order, ok := structOrders.Load(t); ok {
+		return order.([]int)
+	}
+
+	type ranked struct {
+		index, rank int
+	}
+	var tagged []ranked
+	var untagged []int
+	for i := range t.NumField() {
+		tag := t.Field(i).Tag.Get("fg")
+		switch {
+		case tag == "-":
+		case tag == "order":
+			tagged = append(tagged, ranked{index: i})
+		case strings.HasPrefix(tag, "order="):
+			rank, err := strconv.Atoi(strings.TrimPrefix(tag, "order="))
+			if err != nil {
+				panic("invalid fg struct tag on " + t.String() + "." + t.Field(i).Name + ": " + tag)
+			}
+			tagged = append(tagged, ranked{index: i, rank: rank})
+		default:
+			untagged = append(untagged, i)
+		}
+	}
+	slices.SortStableFunc(tagged, func(x, y ranked) int {
+		return cmp.Compare(x.rank, y.rank)
+	})
+
+	order := make([]int, 0, len(tagged)+len(untagged))
+	for _, r := range tagged {
+		order = append(order, r.index)
+	}
+	order = append(order, untagged...)
+	structOrders.Store(t, order)
+	return order
+}
@@ -0,0 +1,242 @@
+package functionalgo
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+type version struct {
+	Major, Minor int
+}
+
+type release struct {
+	Name    string  `fg:"order=2"`
+	Version version `fg:"order=1"`
+	Notes   string  `fg:"-"`
+	Tags    []string
+	when    time.Time
+}
+
+type node struct {
+	value int
+	next  *node
+}
+
+type caseInsensitive string
+
+func TestCompareStructs(t *testing.T) {
+	t.Run("tagged fields are compared first", func(t *testing.T) {
+		a := release{Name: "b", Version: version{1, 2}}
+		b := release{Name: "a", Version: version{1, 10}}
+		if Compare(a, b) != LT {
+			t.Errorf("Expected Version to be compared before Name")
+		}
+	})
+
+	t.Run("ignored fields do not matter", func(t *testing.T) {
+		a := release{Name: "x", Notes: "first"}
+		b := release{Name: "x", Notes: "second"}
+		if Compare(a, b) != EQ {
+			t.Errorf("Expected Notes to be ignored")
+		}
+	})
+
+	t.Run("untagged fields break ties", func(t *testing.T) {
+		a := release{Name: "x", Tags: []string{"beta"}}
+		b := release{Name: "x", Tags: []string{"beta", "rc"}}
+		if Compare(a, b) != LT {
+			t.Errorf("Expected shorter Tags prefix to be LT")
+		}
+	})
+
+	t.Run("unexported time field", func(t *testing.T) {
+		now := time.Now()
+		a := release{when: now}
+		b := release{when: now.Add(time.Second).In(time.UTC)}
+		if Compare(a, b) != LT {
+			t.Errorf("Expected earlier time to be LT")
+		}
+		if Compare(release{when: now}, release{when: now.In(time.UTC)}) != EQ {
+			t.Errorf("Expected the same instant in different locations to be EQ")
+		}
+	})
+
+	t.Run("maximum over structs", func(t *testing.T) {
+		src := []version{{1, 9}, {2, 0}, {1, 12}}
+		if result := Maximum(src); result != (version{2, 0}) {
+			t.Errorf("Expected {2 0}, got %v", result)
+		}
+		if result := Minimum(src); result != (version{1, 9}) {
+			t.Errorf("Expected {1 9}, got %v", result)
+		}
+	})
+}
+
+func TestCompareCollections(t *testing.T) {
+	t.Run("slices are lexicographic", func(t *testing.T) {
+		if Compare([]int{1, 2, 3}, []int{1, 3}) != LT {
+			t.Errorf("Expected [1 2 3] < [1 3]")
+		}
+		if Compare([]int{1, 2}, []int{1, 2}) != EQ {
+			t.Errorf("Expected equal slices to be EQ")
+		}
+		if Compare([]int(nil), []int{}) != EQ {
+			t.Errorf("Expected nil and empty slices to be EQ")
+		}
+	})
+
+	t.Run("arrays", func(t *testing.T) {
+		if Compare([2]string{"a", "z"}, [2]string{"b", "a"}) != LT {
+			t.Errorf("Expected first element to decide")
+		}
+	})
+
+	t.Run("byte slices", func(t *testing.T) {
+		if Compare([]byte("abd"), []byte("abc")) != GT {
+			t.Errorf("Expected abd > abc")
+		}
+	})
+
+	t.Run("maps by sorted keys", func(t *testing.T) {
+		a := map[string]int{"b": 1, "a": 2}
+		b := map[string]int{"a": 2, "c": 0}
+		if Compare(a, b) != LT {
+			t.Errorf("Expected b < c at the second key")
+		}
+		if Compare(map[string]int{"a": 1}, map[string]int{"a": 2}) != LT {
+			t.Errorf("Expected values to be compared when keys match")
+		}
+		if Compare(map[string]int{"a": 1}, map[string]int{"a": 1}) != EQ {
+			t.Errorf("Expected equal maps to be EQ")
+		}
+	})
+
+	t.Run("slices of non-comparable structs", func(t *testing.T) {
+		src := []release{{Name: "b", Tags: []string{"x"}}, {Name: "c"}, {Name: "a"}}
+		if result := Maximum(src); result.Name != "c" {
+			t.Errorf("Expected c, got %v", result.Name)
+		}
+	})
+}
+
+func TestComparePointers(t *testing.T) {
+	one, two := 1, 2
+
+	t.Run("pointee is compared", func(t *testing.T) {
+		if Compare(&one, &two) != LT {
+			t.Errorf("Expected *1 < *2")
+		}
+	})
+
+	t.Run("nil first", func(t *testing.T) {
+		if Compare(nil, &one) != LT || Compare(&one, nil) != GT || Compare[*int](nil, nil) != EQ {
+			t.Errorf("Expected nil to sort first")
+		}
+	})
+
+	t.Run("cyclic structures terminate", func(t *testing.T) {
+		a := &node{value: 1}
+		a.next = a
+		b := &node{value: 1}
+		b.next = b
+		if Compare(a, b) != EQ {
+			t.Errorf("Expected equal cycles to be EQ")
+		}
+	})
+
+	t.Run("interfaces by dynamic value", func(t *testing.T) {
+		if Compare[any](version{1, 0}, version{0, 9}) != GT {
+			t.Errorf("Expected {1 0} > {0 9}")
+		}
+		if Compare[any](nil, 1) != LT {
+			t.Errorf("Expected nil interface to sort first")
+		}
+	})
+}
+
+func TestCompareSpecialTypes(t *testing.T) {
+	t.Run("big int", func(t *testing.T) {
+		huge, _ := new(big.Int).SetString("100000000000000000000", 10)
+		if Compare(big.NewInt(5), huge) != LT {
+			t.Errorf("Expected 5 < 10^20")
+		}
+		if Compare(*huge, *big.NewInt(5)) != GT {
+			t.Errorf("Expected 10^20 > 5 for big.Int values")
+		}
+		if Compare((*big.Int)(nil), big.NewInt(0)) != LT {
+			t.Errorf("Expected nil *big.Int to sort first")
+		}
+	})
+
+	t.Run("time", func(t *testing.T) {
+		now := time.Now()
+		if Compare(now, now.Add(-time.Hour)) != GT {
+			t.Errorf("Expected now > an hour ago")
+		}
+	})
+
+	t.Run("values that are not addressable", func(t *testing.T) {
+		lo, hi := *big.NewInt(-10), *big.NewInt(9)
+		if Compare(map[string]big.Int{"a": lo}, map[string]big.Int{"a": hi}) != LT {
+			t.Errorf("Expected -10 < 9 for big.Int map values")
+		}
+		if Compare(any(lo), any(hi)) != LT {
+			t.Errorf("Expected -10 < 9 for big.Int values in an interface")
+		}
+		now := time.Now()
+		if Compare(map[string]time.Time{"a": now}, map[string]time.Time{"a": now.In(time.UTC)}) != EQ {
+			t.Errorf("Expected the same instant in another location to be EQ in a map")
+		}
+		if Compare(any(now.Add(time.Second)), any(now.In(time.UTC))) != GT {
+			t.Errorf("Expected the later time to be GT in an interface")
+		}
+	})
+
+	t.Run("values reached through unexported fields", func(t *testing.T) {
+		type ledger struct {
+			balances map[string]big.Int
+			stamps   []any
+		}
+		type book struct {
+			ledgers map[string]ledger
+		}
+		now := time.Now()
+		a := book{map[string]ledger{"x": {map[string]big.Int{"a": *big.NewInt(-10)}, []any{now}}}}
+		b := book{map[string]ledger{"x": {map[string]big.Int{"a": *big.NewInt(9)}, []any{now}}}}
+		if Compare(a, b) != LT {
+			t.Errorf("Expected -10 < 9 inside unexported maps")
+		}
+		c := book{map[string]ledger{"x": {map[string]big.Int{"a": *big.NewInt(-10)}, []any{now.In(time.UTC)}}}}
+		if Compare(a, c) != EQ {
+			t.Errorf("Expected the same instant in another location to be EQ inside unexported fields")
+		}
+	})
+
+	t.Run("func values panic", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("Expected comparing funcs to panic")
+			}
+		}()
+		Compare(func() {}, func() {})
+	})
+}
+
+func TestRegisterCompare(t *testing.T) {
+	RegisterCompare(func(a, b caseInsensitive) ComparisonResult {
+		return CompareOrd(strings.ToLower(string(a)), strings.ToLower(string(b)))
+	})
+
+	if Compare(caseInsensitive("ABC"), caseInsensitive("abc")) != EQ {
+		t.Errorf("Expected registered ordering to be used")
+	}
+	nested := []caseInsensitive{"b", "A"}
+	if result := Minimum(nested); result != "A" {
+		t.Errorf("Expected A, got %v", result)
+	}
+	if Compare([]caseInsensitive{"x", "Y"}, []caseInsensitive{"X", "y"}) != EQ {
+		t.Errorf("Expected registered ordering to apply to nested values")
+	}
+}
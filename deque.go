@@ -0,0 +1,175 @@
+package functionalgo
+
+// Deque is a persistent double-ended queue using Okasaki's real-time
+// representation. Every push, pop and peek is worst-case O(1): the front and
+// the reversed rear are lazy streams kept within a constant factor of each
+// other, and each operation forces a few cells of a schedule so that no
+// rebalance is ever paid for all at once. The zero value is an empty deque.
+type Deque[T any] struct {
+	front, frontSched *stream[T]
+	lenFront          int
+	rear, rearSched   *stream[T]
+	lenRear           int
+}
+
+// dequeC bounds how much longer one side may grow than the other.
+const dequeC = 3
+
+func DequeOf[T any](xs ...T) Deque[T] {
+	return DequeFromSlice(xs)
+}
+
+func DequeFromSlice[T any](src []T) Deque[T] {
+	half := len(src) / 2
+	return Deque[T]{
+		front:    sliceStream(src[:half]),
+		lenFront: half,
+		rear:     sliceStream(Reverse(src[half:])),
+		lenRear:  len(src) - half,
+	}
+}
+
+// checkDeque rebalances the sides when one outgrows the other, moving the
+// excess over incrementally through rotateDrop.
+func checkDeque[T any](front *stream[T], lenFront int, frontSched *stream[T], rear *stream[T], lenRear int, rearSched *stream[T]) Deque[T] {
+	switch total := lenFront + lenRear; {
+	case lenFront > dequeC*lenRear+1:
+		i := total / 2
+		f := takeStream(i, front)
+		r := rotateDrop(rear, i, front)
+		return Deque[T]{f, f, i, r, r, total - i}
+	case lenRear > dequeC*lenFront+1:
+		j := total / 2
+		r := takeStream(j, rear)
+		f := rotateDrop(front, j, rear)
+		return Deque[T]{f, f, total - j, r, r, j}
+	}
+	return Deque[T]{front, frontSched, lenFront, rear, rearSched, lenRear}
+}
+
+// rotateDrop computes r ++ reverse(drop(i, f)), dequeC elements of f per cell.
+func rotateDrop[T any](r *stream[T], i int, f *stream[T]) *stream[T] {
+	return lazyStream(func() *streamCell[T] {
+		if i < dequeC {
+			return rotateRev(r, dropStream(i, f), nil).force()
+		}
+		c := r.force()
+		return &streamCell[T]{c.head, rotateDrop(c.tail, i-dequeC, dropStream(dequeC, f))}
+	})
+}
+
+// rotateRev computes r ++ reverse(f) ++ a, dequeC elements of f per cell.
+func rotateRev[T any](r, f, a *stream[T]) *stream[T] {
+	return lazyStream(func() *streamCell[T] {
+		c := r.force()
+		if c == nil {
+			return appendStream(reverseStream(f), a).force()
+		}
+		a := appendStream(reverseStream(takeStream(dequeC, f)), a)
+		return &streamCell[T]{c.head, rotateRev(c.tail, dropStream(dequeC, f), a)}
+	})
+}
+
+func (d Deque[T]) Len() int {
+	return d.lenFront + d.lenRear
+}
+
+func (d Deque[T]) IsEmpty() bool {
+	return d.Len() == 0
+}
+
+func (d Deque[T]) PushFront(x T) Deque[T] {
+	return checkDeque(consStream(x, d.front), d.lenFront+1, dropStream(1, d.frontSched),
+		d.rear, d.lenRear, dropStream(1, d.rearSched))
+}
+
+func (d Deque[T]) PushBack(x T) Deque[T] {
+	return checkDeque(d.front, d.lenFront, dropStream(1, d.frontSched),
+		consStream(x, d.rear), d.lenRear+1, dropStream(1, d.rearSched))
+}
+
+func (d Deque[T]) PeekFront() Option[T] {
+	return peekDeque(d.front, d.rear)
+}
+
+func (d Deque[T]) PeekBack() Option[T] {
+	return peekDeque(d.rear, d.front)
+}
+
+// PopFront returns the front element, or None if d is empty, and the deque
+// without it.
+func (d Deque[T]) PopFront() (Option[T], Deque[T]) {
+	c := d.front.force()
+	if c == nil {
+		return d.PeekBack(), Deque[T]{}
+	}
+	return Some(c.head), checkDeque(c.tail, d.lenFront-1, dropStream(2, d.frontSched),
+		d.rear, d.lenRear, dropStream(2, d.rearSched))
+}
+
+// PopBack returns the back element, or None if d is empty, and the deque
+// without it.
+func (d Deque[T]) PopBack() (Option[T], Deque[T]) {
+	c := d.rear.force()
+	if c == nil {
+		return d.PeekFront(), Deque[T]{}
+	}
+	return Some(c.head), checkDeque(d.front, d.lenFront, dropStream(2, d.frontSched),
+		c.tail, d.lenRear-1, dropStream(2, d.rearSched))
+}
+
+// peekDeque returns the head of side, falling back to other, which holds at
+// most one element whenever side is empty.
+func peekDeque[T any](side, other *stream[T]) Option[T] {
+	if c := side.force(); c != nil {
+		return Some(c.head)
+	}
+	if c := other.force(); c != nil {
+		return Some(c.head)
+	}
+	return None[T]()
+}
+
+// Seq yields the elements from front to back.
+func (d Deque[T]) Seq() Seq[T] {
+	return func(yield func(T) bool) {
+		for x := range d.front.seq() {
+			if !yield(x) {
+				return
+			}
+		}
+		for _, x := range Reverse(Collect(d.rear.seq())) {
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// Descending yields the elements from back to front.
+func (d Deque[T]) Descending() Seq[T] {
+	return func(yield func(T) bool) {
+		for x := range d.rear.seq() {
+			if !yield(x) {
+				return
+			}
+		}
+		for _, x := range Reverse(Collect(d.front.seq())) {
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+func (d Deque[T]) ToSlice() []T {
+	return AppendSeq(make([]T, 0, d.Len()), d.Seq())
+}
+
+func MapDeque[A any, B any](fn func(A) B, d Deque[A]) Deque[B] {
+	return DequeFromSlice(Map(fn, d.ToSlice()))
+}
+
+func FoldlDeque[A any, B any](fn func(B, A) B, acc B, d Deque[A]) B {
+	return FoldlSeq(fn, acc, d.Seq())
+}
@@ -0,0 +1,118 @@
+package functionalgo
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func checkDequeBalance[T any](t *testing.T, d Deque[T]) {
+	t.Helper()
+	front, rear := len(Collect(d.front.seq())), len(Collect(d.rear.seq()))
+	if front != d.lenFront || rear != d.lenRear {
+		t.Fatalf("Expected side lengths %d and %d, got %d and %d", d.lenFront, d.lenRear, front, rear)
+	}
+	if front > dequeC*rear+1 || rear > dequeC*front+1 {
+		t.Fatalf("Expected balanced sides, got %d and %d", front, rear)
+	}
+}
+
+func TestDequeBasics(t *testing.T) {
+	var empty Deque[int]
+	if !empty.IsEmpty() || empty.PeekFront().IsSome() || empty.PeekBack().IsSome() {
+		t.Errorf("Expected the zero value to be an empty deque")
+	}
+	if x, _ := empty.PopBack(); x.IsSome() {
+		t.Errorf("Expected popping an empty deque to return None")
+	}
+
+	d := DequeOf(2, 3).PushFront(1).PushBack(4)
+	if result := d.ToSlice(); !reflect.DeepEqual(result, []int{1, 2, 3, 4}) {
+		t.Errorf("Expected [1 2 3 4], got %v", result)
+	}
+	if result := Collect(d.Descending()); !reflect.DeepEqual(result, []int{4, 3, 2, 1}) {
+		t.Errorf("Expected [4 3 2 1], got %v", result)
+	}
+	if d.PeekFront() != Some(1) || d.PeekBack() != Some(4) {
+		t.Errorf("Expected ends 1 and 4, got %v and %v", d.PeekFront(), d.PeekBack())
+	}
+
+	t.Run("single element is reachable from both ends", func(t *testing.T) {
+		one := Deque[int]{}.PushBack(7)
+		if one.PeekFront() != Some(7) || one.PeekBack() != Some(7) {
+			t.Errorf("Expected Some(7) at both ends")
+		}
+		x, rest := one.PopFront()
+		if x != Some(7) || !rest.IsEmpty() {
+			t.Errorf("Expected Some(7) and an empty deque, got %v and %v", x, rest.ToSlice())
+		}
+	})
+}
+
+func TestDequeOneSided(t *testing.T) {
+	var d Deque[int]
+	for i := range 10000 {
+		d = d.PushBack(i)
+	}
+	checkDequeBalance(t, d)
+	for i := range 10000 {
+		x, rest := d.PopFront()
+		if x != Some(i) {
+			t.Fatalf("Expected Some(%d), got %v", i, x)
+		}
+		d = rest
+	}
+	if !d.IsEmpty() {
+		t.Errorf("Expected an empty deque, got %d elements", d.Len())
+	}
+}
+
+func TestDequeCombinators(t *testing.T) {
+	d := DequeOf(1, 2, 3).PushFront(0)
+	if result := MapDeque(func(x int) int { return x * 10 }, d).ToSlice(); !reflect.DeepEqual(result, []int{0, 10, 20, 30}) {
+		t.Errorf("Expected [0 10 20 30], got %v", result)
+	}
+	if result := FoldlDeque(func(acc []int, x int) []int { return append(acc, x) }, nil, d); !reflect.DeepEqual(result, []int{0, 1, 2, 3}) {
+		t.Errorf("Expected [0 1 2 3], got %v", result)
+	}
+}
+
+func TestDequeRandomOps(t *testing.T) {
+	rng := rand.New(rand.NewPCG(7, 8))
+	type version struct {
+		d     Deque[int]
+		model []int
+	}
+	versions := []version{{}}
+	for step := range 4000 {
+		v := versions[rng.IntN(len(versions))]
+		switch op := rng.IntN(6); {
+		case op < 2:
+			v = version{v.d.PushFront(step), slices.Concat([]int{step}, v.model)}
+		case op < 4:
+			v = version{v.d.PushBack(step), slices.Concat(v.model, []int{step})}
+		case len(v.model) == 0:
+			continue
+		case op == 4:
+			x, d := v.d.PopFront()
+			if x != Some(v.model[0]) {
+				t.Fatalf("Step %d: expected Some(%d), got %v", step, v.model[0], x)
+			}
+			v = version{d, v.model[1:]}
+		default:
+			x, d := v.d.PopBack()
+			if x != Some(v.model[len(v.model)-1]) {
+				t.Fatalf("Step %d: expected Some(%d), got %v", step, v.model[len(v.model)-1], x)
+			}
+			v = version{d, v.model[:len(v.model)-1]}
+		}
+		checkDequeBalance(t, v.d)
+		versions = append(versions, v)
+	}
+	for _, v := range versions {
+		if !slices.Equal(v.d.ToSlice(), v.model) {
+			t.Fatalf("Expected %v, got %v", v.model, v.d.ToSlice())
+		}
+	}
+}
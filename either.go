@@ -0,0 +1,89 @@
+package functionalgo
+
+import "fmt"
+
+// Either holds a value of one of two types. By convention Left carries the
+// failure or alternative case and Right the success case; the mapping
+// functions are right-biased.
+type Either[L any, R any] struct {
+	left    L
+	right   R
+	isRight bool
+}
+
+func Left[L any, R any](v L) Either[L, R] {
+	return Either[L, R]{left: v}
+}
+
+func Right[L any, R any](v R) Either[L, R] {
+	return Either[L, R]{right: v, isRight: true}
+}
+
+func (e Either[L, R]) IsLeft() bool {
+	return !e.isRight
+}
+
+func (e Either[L, R]) IsRight() bool {
+	return e.isRight
+}
+
+func (e Either[L, R]) GetLeft() (L, bool) {
+	return e.left, !e.isRight
+}
+
+func (e Either[L, R]) GetRight() (R, bool) {
+	return e.right, e.isRight
+}
+
+func (e Either[L, R]) Swap() Either[R, L] {
+	return Either[R, L]{left: e.right, right: e.left, isRight: !e.isRight}
+}
+
+func (e Either[L, R]) String() string {
+	if e.isRight {
+		return fmt.Sprintf("Right(%v)", e.right)
+	}
+	return fmt.Sprintf("Left(%v)", e.left)
+}
+
+func MapEither[L any, A any, B any](fn func(A) B, e Either[L, A]) Either[L, B] {
+	if !e.isRight {
+		return Left[L, B](e.left)
+	}
+	return Right[L](fn(e.right))
+}
+
+func MapLeft[L any, M any, R any](fn func(L) M, e Either[L, R]) Either[M, R] {
+	if e.isRight {
+		return Right[M](e.right)
+	}
+	return Left[M, R](fn(e.left))
+}
+
+func FlatMapEither[L any, A any, B any](fn func(A) Either[L, B], e Either[L, A]) Either[L, B] {
+	if !e.isRight {
+		return Left[L, B](e.left)
+	}
+	return fn(e.right)
+}
+
+func MatchEither[L any, R any, T any](left func(L) T, right func(R) T, e Either[L, R]) T {
+	if e.isRight {
+		return right(e.right)
+	}
+	return left(e.left)
+}
+
+func ResultToEither[T any](r Result[T]) Either[error, T] {
+	if r.err != nil {
+		return Left[error, T](r.err)
+	}
+	return Right[error](r.value)
+}
+
+func EitherToResult[T any](e Either[error, T]) Result[T] {
+	if e.isRight {
+		return Ok(e.right)
+	}
+	return Err[T](e.left)
+}
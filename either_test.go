@@ -0,0 +1,104 @@
+package functionalgo
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestEither(t *testing.T) {
+	t.Run("left", func(t *testing.T) {
+		e := Left[string, int]("missing")
+		if !e.IsLeft() || e.IsRight() {
+			t.Errorf("Expected Left to be Left")
+		}
+		if v, ok := e.GetLeft(); !ok || v != "missing" {
+			t.Errorf("Expected (missing, true), got (%v, %v)", v, ok)
+		}
+		if _, ok := e.GetRight(); ok {
+			t.Errorf("Expected GetRight on Left to fail")
+		}
+		if e.String() != "Left(missing)" {
+			t.Errorf("Expected Left(missing), got %v", e.String())
+		}
+	})
+
+	t.Run("right", func(t *testing.T) {
+		e := Right[string](3)
+		if e.IsLeft() || !e.IsRight() {
+			t.Errorf("Expected Right to be Right")
+		}
+		if v, ok := e.GetRight(); !ok || v != 3 {
+			t.Errorf("Expected (3, true), got (%v, %v)", v, ok)
+		}
+		if e.String() != "Right(3)" {
+			t.Errorf("Expected Right(3), got %v", e.String())
+		}
+	})
+
+	t.Run("swap", func(t *testing.T) {
+		e := Right[string](3).Swap()
+		if v, ok := e.GetLeft(); !ok || v != 3 {
+			t.Errorf("Expected Left(3), got %v", e)
+		}
+	})
+}
+
+func TestMapEither(t *testing.T) {
+	if e := MapEither(strconv.Itoa, Right[bool](2)); e != Right[bool]("2") {
+		t.Errorf("Expected Right(2), got %v", e)
+	}
+	if e := MapEither(strconv.Itoa, Left[bool, int](true)); e != Left[bool, string](true) {
+		t.Errorf("Expected Left(true), got %v", e)
+	}
+}
+
+func TestMapLeft(t *testing.T) {
+	if e := MapLeft(strconv.Itoa, Left[int, bool](2)); e != Left[string, bool]("2") {
+		t.Errorf("Expected Left(2), got %v", e)
+	}
+	if e := MapLeft(strconv.Itoa, Right[int](true)); e != Right[string](true) {
+		t.Errorf("Expected Right(true), got %v", e)
+	}
+}
+
+func TestFlatMapEither(t *testing.T) {
+	half := func(n int) Either[string, int] {
+		if n%2 != 0 {
+			return Left[string, int]("odd")
+		}
+		return Right[string](n / 2)
+	}
+	if e := FlatMapEither(half, Right[string](8)); e != Right[string](4) {
+		t.Errorf("Expected Right(4), got %v", e)
+	}
+	if e := FlatMapEither(half, Right[string](7)); e != Left[string, int]("odd") {
+		t.Errorf("Expected Left(odd), got %v", e)
+	}
+}
+
+func TestMatchEither(t *testing.T) {
+	describe := func(e Either[string, int]) string {
+		return MatchEither(func(s string) string { return "left " + s }, strconv.Itoa, e)
+	}
+	if describe(Left[string, int]("x")) != "left x" {
+		t.Errorf("Expected 'left x', got %v", describe(Left[string, int]("x")))
+	}
+	if describe(Right[string](1)) != "1" {
+		t.Errorf("Expected 1, got %v", describe(Right[string](1)))
+	}
+}
+
+func TestResultEitherConversion(t *testing.T) {
+	if e := ResultToEither(Ok(1)); e != Right[error](1) {
+		t.Errorf("Expected Right(1), got %v", e)
+	}
+	if e := ResultToEither(Err[int](errTest)); e != Left[error, int](errTest) {
+		t.Errorf("Expected Left(boom), got %v", e)
+	}
+	if r := EitherToResult(Left[error, int](errTest)); r.Err() != errTest {
+		t.Errorf("Expected Err(boom), got %v", r)
+	}
+	if r := EitherToResult(Right[error](2)); r != Ok(2) {
+		t.Errorf("Expected Ok(2), got %v", r)
+	}
+}
@@ -0,0 +1,249 @@
+package functionalgo
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+type (
+	Integer interface {
+		~int | ~int8 | ~int16 | ~int32 | ~int64 |
+			~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+	}
+	Float interface {
+		~float32 | ~float64
+	}
+	Number interface {
+		Integer | Float
+	}
+)
+
+// Enum is implemented by user-defined enumerations, such as ComparisonResult,
+// to step through their values. Succ and Pred should panic at the ends.
+type Enum[T any] interface {
+	Succ() T
+	Pred() T
+}
+
+// Bounded is implemented by types with a smallest and largest value. The
+// methods are called on the zero value.
+type Bounded[T any] interface {
+	MinBound() T
+	MaxBound() T
+}
+
+func Replicate[T any](n int, val T) []T {
+	result := make([]T, max(n, 0))
+	for i := range result {
+		result[i] = val
+	}
+	return result
+}
+
+func ReplicateSeq[T any](n int, val T) Seq[T] {
+	return TakeSeq(Repeat(val), n)
+}
+
+// EnumOrd is an ordered type that steps through its values with its own Succ
+// and Pred methods, such as ComparisonResult.
+type EnumOrd[T any] interface {
+	Ord
+	Enum[T]
+}
+
+// BoundedEnum is an EnumOrd with a smallest and largest value.
+type BoundedEnum[T any] interface {
+	EnumOrd[T]
+	Bounded[T]
+}
+
+// Succ returns x+1. It panics on the maximum value of an integer type.
+func Succ[T Number](x T) T {
+	y := x + 1
+	if y < x {
+		panic(fmt.Sprintf("Succ: %v is the maximum bound", x))
+	}
+	return y
+}
+
+// Pred returns x-1. It panics on the minimum value of an integer type.
+func Pred[T Number](x T) T {
+	y := x - 1
+	if y > x {
+		panic(fmt.Sprintf("Pred: %v is the minimum bound", x))
+	}
+	return y
+}
+
+// MinBound returns the smallest value of an integer type, or of its MinBound
+// method if it implements Bounded.
+func MinBound[T Integer]() T {
+	var zero T
+	if b, ok := any(zero).(Bounded[T]); ok {
+		return b.MinBound()
+	}
+	lo, _ := bounds[T]()
+	return lo
+}
+
+// MaxBound returns the largest value of an integer type, or of its MaxBound
+// method if it implements Bounded.
+func MaxBound[T Integer]() T {
+	var zero T
+	if b, ok := any(zero).(Bounded[T]); ok {
+		return b.MaxBound()
+	}
+	_, hi := bounds[T]()
+	return hi
+}
+
+// EnumFromTo returns from, from+1, ... up to and including to. Use
+// EnumFromToBy for types that step with their own Succ method.
+func EnumFromTo[T Number](from, to T) []T {
+	return Collect(EnumFromToSeq(from, to))
+}
+
+func EnumFromToSeq[T Number](from, to T) Seq[T] {
+	if isFloatKind(reflect.TypeFor[T]().Kind()) {
+		return floatRange(from, to, 1, true)
+	}
+	return integerRange(from, to, 1, true, true)
+}
+
+// EnumFromSeq yields from, from+1, ..., ending at the maximum value of an
+// integer type and continuing forever for floats.
+func EnumFromSeq[T Number](from T) Seq[T] {
+	if isFloatKind(reflect.TypeFor[T]().Kind()) {
+		inf := math.Inf(1)
+		return floatRange(from, T(inf), 1, true)
+	}
+	_, hi := bounds[T]()
+	return integerRange(from, hi, 1, true, true)
+}
+
+// EnumFromToBy returns from, from.Succ(), ... up to and including to.
+func EnumFromToBy[T EnumOrd[T]](from, to T) []T {
+	return Collect(EnumFromToBySeq(from, to))
+}
+
+func EnumFromToBySeq[T EnumOrd[T]](from, to T) Seq[T] {
+	return func(yield func(T) bool) {
+		for x := from; x <= to; x = x.Succ() {
+			if !yield(x) || x == to {
+				return
+			}
+		}
+	}
+}
+
+// EnumAll returns every value of a bounded enumeration in order.
+func EnumAll[T BoundedEnum[T]]() []T {
+	var zero T
+	return EnumFromToBy(zero.MinBound(), zero.MaxBound())
+}
+
+// EnumFromThenTo returns from, then, ... stepping by then-from, up to and
+// including to in the direction of the step. It panics if from equals then.
+func EnumFromThenTo[T Number](from, then, to T) []T {
+	return Collect(EnumFromThenToSeq(from, then, to))
+}
+
+func EnumFromThenToSeq[T Number](from, then, to T) Seq[T] {
+	if from == then {
+		panic("EnumFromThenTo: step must not be zero")
+	}
+	if isFloatKind(reflect.TypeFor[T]().Kind()) {
+		return floatRange(from, to, then-from, true)
+	}
+	if then > from {
+		return integerRange(from, to, uint64(then)-uint64(from), true, true)
+	}
+	return integerRange(from, to, uint64(from)-uint64(then), false, true)
+}
+
+// Range returns start, start+step, ... up to but excluding stop. A negative
+// step counts down. It panics if step is zero.
+func Range[T Number](start, stop, step T) []T {
+	return Collect(RangeSeq(start, stop, step))
+}
+
+func RangeSeq[T Number](start, stop, step T) Seq[T] {
+	var zero T
+	if step == zero {
+		panic("Range: step must not be zero")
+	}
+	if isFloatKind(reflect.TypeFor[T]().Kind()) {
+		return floatRange(start, stop, step, false)
+	}
+	if step > zero {
+		return integerRange(start, stop, uint64(step), true, false)
+	}
+	return integerRange(start, stop, -uint64(step), false, false)
+}
+
+// integerRange steps from start towards stop by dist. Distances are taken as
+// differences of the values converted to uint64, which are exact for every
+// integer type as long as the larger value comes first, so neither the step
+// nor the remaining gap can overflow.
+func integerRange[T Number](start, stop T, dist uint64, up, inclusive bool) Seq[T] {
+	return func(yield func(T) bool) {
+		for x := start; inRange(x, stop, up, inclusive); {
+			if !yield(x) {
+				return
+			}
+			if up && uint64(stop)-uint64(x) < dist || !up && uint64(x)-uint64(stop) < dist {
+				return
+			}
+			if up {
+				x += T(dist)
+			} else {
+				x -= T(dist)
+			}
+		}
+	}
+}
+
+// floatRange computes each element as start+i*step to avoid accumulating
+// rounding errors.
+func floatRange[T Number](start, stop, step T, inclusive bool) Seq[T] {
+	var zero T
+	return func(yield func(T) bool) {
+		for i, x := 0, start; inRange(x, stop, step > zero, inclusive); i++ {
+			if !yield(x) {
+				return
+			}
+			x = start + T(i+1)*step
+		}
+	}
+}
+
+func inRange[T Number](x, stop T, up, inclusive bool) bool {
+	switch {
+	case up && inclusive:
+		return x <= stop
+	case up:
+		return x < stop
+	case inclusive:
+		return x >= stop
+	default:
+		return x > stop
+	}
+}
+
+func isFloatKind(k reflect.Kind) bool {
+	return k == reflect.Float32 || k == reflect.Float64
+}
+
+// bounds returns the limits of an integer type T.
+func bounds[T Number]() (lo, hi T) {
+	vlo, vhi := reflect.ValueOf(&lo).Elem(), reflect.ValueOf(&hi).Elem()
+	bits := vlo.Type().Size() * 8
+	if vlo.CanInt() {
+		vlo.SetInt(-1 << (bits - 1))
+		vhi.SetInt(1<<(bits-1) - 1)
+	} else {
+		vhi.SetUint(^uint64(0) >> (64 - bits))
+	}
+	return lo, hi
+}
@@ -0,0 +1,294 @@
+package functionalgo
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+type weekday int
+
+const (
+	monday weekday = iota
+	tuesday
+	wednesday
+)
+
+func (d weekday) Succ() weekday {
+	if d == wednesday {
+		panic("no day after wednesday")
+	}
+	return d + 1
+}
+
+func (d weekday) Pred() weekday {
+	if d == monday {
+		panic("no day before monday")
+	}
+	return d - 1
+}
+
+func (weekday) MinBound() weekday { return monday }
+func (weekday) MaxBound() weekday { return wednesday }
+
+// permission steps through powers of two, so its Succ differs from +1.
+type permission uint8
+
+const (
+	read permission = 1 << iota
+	write
+	execute
+)
+
+func (p permission) Succ() permission {
+	if p == execute {
+		panic("no permission after execute")
+	}
+	return p << 1
+}
+
+func (p permission) Pred() permission {
+	if p == read {
+		panic("no permission before read")
+	}
+	return p >> 1
+}
+
+func (permission) MinBound() permission { return read }
+func (permission) MaxBound() permission { return execute }
+
+func expectPanic(t *testing.T, name string, fn func()) {
+	t.Helper()
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected %s to panic", name)
+		}
+	}()
+	fn()
+}
+
+func TestReplicate(t *testing.T) {
+	if result := Replicate(3, "a"); !reflect.DeepEqual(result, []string{"a", "a", "a"}) {
+		t.Errorf("Expected [a a a], got %v", result)
+	}
+	if result := Replicate(-1, 0); !reflect.DeepEqual(result, []int{}) {
+		t.Errorf("Expected [], got %v", result)
+	}
+	if result := Collect(ReplicateSeq(2, true)); !reflect.DeepEqual(result, []bool{true, true}) {
+		t.Errorf("Expected [true true], got %v", result)
+	}
+}
+
+func TestSuccPred(t *testing.T) {
+	t.Run("numbers", func(t *testing.T) {
+		if result := Succ(41); result != 42 {
+			t.Errorf("Expected 42, got %v", result)
+		}
+		if result := Pred(uint8(1)); result != 0 {
+			t.Errorf("Expected 0, got %v", result)
+		}
+		if result := Succ(celsius(1.5)); result != 2.5 {
+			t.Errorf("Expected 2.5, got %v", result)
+		}
+	})
+
+	t.Run("named integer types", func(t *testing.T) {
+		if result := Pred(wednesday); result != tuesday {
+			t.Errorf("Expected tuesday, got %v", result)
+		}
+	})
+
+	t.Run("past the bounds", func(t *testing.T) {
+		expectPanic(t, "Succ(int8(127))", func() { Succ(int8(127)) })
+		expectPanic(t, "Pred(uint(0))", func() { Pred(uint(0)) })
+		expectPanic(t, "Succ(math.MaxInt64)", func() { Succ(int64(math.MaxInt64)) })
+	})
+}
+
+func TestBounds(t *testing.T) {
+	if MinBound[int8]() != -128 || MaxBound[int8]() != 127 {
+		t.Errorf("Expected int8 bounds -128 and 127")
+	}
+	if MaxBound[uint16]() != 65535 {
+		t.Errorf("Expected 65535, got %v", MaxBound[uint16]())
+	}
+	if MinBound[ComparisonResult]() != LT || MaxBound[weekday]() != wednesday {
+		t.Errorf("Expected bounds from Bounded methods")
+	}
+}
+
+func TestEnumFromTo(t *testing.T) {
+	t.Run("integers", func(t *testing.T) {
+		if result := EnumFromTo(1, 4); !reflect.DeepEqual(result, []int{1, 2, 3, 4}) {
+			t.Errorf("Expected [1 2 3 4], got %v", result)
+		}
+		if result := EnumFromTo(4, 1); !reflect.DeepEqual(result, []int{}) {
+			t.Errorf("Expected [], got %v", result)
+		}
+	})
+
+	t.Run("up to the maximum bound", func(t *testing.T) {
+		result := EnumFromTo(int8(125), int8(127))
+		if !reflect.DeepEqual(result, []int8{125, 126, 127}) {
+			t.Errorf("Expected [125 126 127], got %v", result)
+		}
+	})
+
+	t.Run("floats stop before passing to", func(t *testing.T) {
+		if result := EnumFromTo(1.0, 3.5); !reflect.DeepEqual(result, []float64{1, 2, 3}) {
+			t.Errorf("Expected [1 2 3], got %v", result)
+		}
+	})
+
+	t.Run("named integer types", func(t *testing.T) {
+		if result := EnumFromTo(monday, wednesday); !reflect.DeepEqual(result, []weekday{monday, tuesday, wednesday}) {
+			t.Errorf("Expected all weekdays, got %v", result)
+		}
+	})
+
+	t.Run("does not allocate per element", func(t *testing.T) {
+		if allocs := testing.AllocsPerRun(10, func() { EnumFromTo(0, 10000) }); allocs > 50 {
+			t.Errorf("Expected at most 50 allocations, got %v", allocs)
+		}
+	})
+}
+
+func TestEnumFromToBy(t *testing.T) {
+	t.Run("steps with Succ", func(t *testing.T) {
+		if result := EnumFromToBy(read, execute); !reflect.DeepEqual(result, []permission{read, write, execute}) {
+			t.Errorf("Expected [1 2 4], got %v", result)
+		}
+		if result := EnumFromToBy(execute, read); !reflect.DeepEqual(result, []permission{}) {
+			t.Errorf("Expected [], got %v", result)
+		}
+		if result := Collect(TakeSeq(EnumFromToBySeq(LT, GT), 2)); !reflect.DeepEqual(result, []ComparisonResult{LT, EQ}) {
+			t.Errorf("Expected [LT EQ], got %v", result)
+		}
+	})
+
+	t.Run("all values", func(t *testing.T) {
+		if result := EnumAll[ComparisonResult](); !reflect.DeepEqual(result, []ComparisonResult{LT, EQ, GT}) {
+			t.Errorf("Expected [LT EQ GT], got %v", result)
+		}
+		if result := EnumAll[permission](); !reflect.DeepEqual(result, []permission{read, write, execute}) {
+			t.Errorf("Expected [1 2 4], got %v", result)
+		}
+	})
+}
+
+func TestEnumFromSeq(t *testing.T) {
+	if result := Collect(EnumFromSeq(uint8(254))); !reflect.DeepEqual(result, []uint8{254, 255}) {
+		t.Errorf("Expected [254 255], got %v", result)
+	}
+	if result := Collect(TakeSeq(EnumFromSeq(10), 3)); !reflect.DeepEqual(result, []int{10, 11, 12}) {
+		t.Errorf("Expected [10 11 12], got %v", result)
+	}
+	if result := Collect(TakeSeq(EnumFromSeq(0.5), 3)); !reflect.DeepEqual(result, []float64{0.5, 1.5, 2.5}) {
+		t.Errorf("Expected [0.5 1.5 2.5], got %v", result)
+	}
+}
+
+func TestEnumFromThenTo(t *testing.T) {
+	tests := []struct {
+		name           string
+		from, then, to int
+		expected       []int
+	}{
+		{"ascending", 1, 3, 9, []int{1, 3, 5, 7, 9}},
+		{"ascending stops before to", 1, 3, 8, []int{1, 3, 5, 7}},
+		{"descending", 10, 7, 0, []int{10, 7, 4, 1}},
+		{"empty", 5, 6, 1, []int{}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if result := EnumFromThenTo(tc.from, tc.then, tc.to); !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, result)
+			}
+		})
+	}
+
+	t.Run("unsigned counting down", func(t *testing.T) {
+		if result := EnumFromThenTo[uint](4, 2, 0); !reflect.DeepEqual(result, []uint{4, 2, 0}) {
+			t.Errorf("Expected [4 2 0], got %v", result)
+		}
+	})
+
+	expectPanic(t, "EnumFromThenTo with zero step", func() { EnumFromThenTo(1, 1, 5) })
+}
+
+func TestRange(t *testing.T) {
+	t.Run("excludes stop", func(t *testing.T) {
+		if result := Range(0, 10, 3); !reflect.DeepEqual(result, []int{0, 3, 6, 9}) {
+			t.Errorf("Expected [0 3 6 9], got %v", result)
+		}
+		if result := Range(0, 9, 3); !reflect.DeepEqual(result, []int{0, 3, 6}) {
+			t.Errorf("Expected [0 3 6], got %v", result)
+		}
+	})
+
+	t.Run("negative step", func(t *testing.T) {
+		if result := Range(5, 0, -2); !reflect.DeepEqual(result, []int{5, 3, 1}) {
+			t.Errorf("Expected [5 3 1], got %v", result)
+		}
+	})
+
+	t.Run("does not overflow near the bound", func(t *testing.T) {
+		if result := Range(int8(120), int8(127), int8(5)); !reflect.DeepEqual(result, []int8{120, 125}) {
+			t.Errorf("Expected [120 125], got %v", result)
+		}
+		if result := EnumFromThenTo(uint8(250), uint8(253), uint8(255)); !reflect.DeepEqual(result, []uint8{250, 253}) {
+			t.Errorf("Expected [250 253], got %v", result)
+		}
+	})
+
+	t.Run("spans crossing zero do not overflow", func(t *testing.T) {
+		if result := Range[int8](-100, 100, 50); !reflect.DeepEqual(result, []int8{-100, -50, 0, 50}) {
+			t.Errorf("Expected [-100 -50 0 50], got %v", result)
+		}
+		if result := Range[int8](100, -100, -50); !reflect.DeepEqual(result, []int8{100, 50, 0, -50}) {
+			t.Errorf("Expected [100 50 0 -50], got %v", result)
+		}
+		if result := EnumFromThenTo[int8](-100, 100, 127); !reflect.DeepEqual(result, []int8{-100, 100}) {
+			t.Errorf("Expected [-100 100], got %v", result)
+		}
+		if result := EnumFromThenTo[int8](100, -100, -128); !reflect.DeepEqual(result, []int8{100, -100}) {
+			t.Errorf("Expected [100 -100], got %v", result)
+		}
+		if result := EnumFromThenTo[int64](math.MinInt64, math.MaxInt64, math.MaxInt64); !reflect.DeepEqual(result, []int64{math.MinInt64, math.MaxInt64}) {
+			t.Errorf("Expected [MinInt64 MaxInt64], got %v", result)
+		}
+	})
+
+	t.Run("minimum step", func(t *testing.T) {
+		if result := Range[int8](127, -128, -128); !reflect.DeepEqual(result, []int8{127, -1}) {
+			t.Errorf("Expected [127 -1], got %v", result)
+		}
+		if result := Range[int64](0, math.MinInt64, math.MinInt64); !reflect.DeepEqual(result, []int64{0}) {
+			t.Errorf("Expected [0], got %v", result)
+		}
+		if result := EnumFromThenTo[int8](0, -128, -128); !reflect.DeepEqual(result, []int8{0, -128}) {
+			t.Errorf("Expected [0 -128], got %v", result)
+		}
+	})
+
+	t.Run("floats do not accumulate rounding errors", func(t *testing.T) {
+		result := Range(0, 1, 0.1)
+		if len(result) != 10 {
+			t.Errorf("Expected 10 elements, got %v", result)
+		}
+	})
+
+	t.Run("named float type", func(t *testing.T) {
+		if result := Range[celsius](0, 1, 0.5); !reflect.DeepEqual(result, []celsius{0, 0.5}) {
+			t.Errorf("Expected [0 0.5], got %v", result)
+		}
+	})
+
+	t.Run("lazy and early exit", func(t *testing.T) {
+		if result := Collect(TakeSeq(RangeSeq(0, 100, 10), 2)); !reflect.DeepEqual(result, []int{0, 10}) {
+			t.Errorf("Expected [0 10], got %v", result)
+		}
+	})
+
+	expectPanic(t, "Range with zero step", func() { Range(0, 1, 0) })
+}
@@ -0,0 +1,454 @@
+package functionalgo
+
+import "slices"
+
+// Measure is a monoid summarising the elements of a FingerTree. Of measures
+// a single element, and Combine must be associative with Empty as identity.
+type Measure[T any, M any] struct {
+	Empty   M
+	Combine func(M, M) M
+	Of      func(T) M
+}
+
+// FingerTree is a persistent 2-3 finger tree (Hinze and Paterson) annotated
+// with a Measure. Pushes and pops at either end are amortised O(1), and
+// Concat, Split and Lookup are O(log n). Create one with FingerTreeWith;
+// Sequence is a finger tree measured by size.
+type FingerTree[T any, M any] struct {
+	root    *ftTree[T, M]
+	measure *Measure[T, M]
+}
+
+// ftNode is either a leaf holding one element or a 2-3 node of the level
+// below. All levels share this type; a node's depth follows from where it
+// sits in the spine.
+type ftNode[T any, M any] struct {
+	value    T
+	children []*ftNode[T, M]
+	measure  M
+}
+
+// ftTree is empty when nil, a single node when prefix is nil, and otherwise
+// deep, with prefix and suffix digits of one to four nodes each.
+type ftTree[T any, M any] struct {
+	single         *ftNode[T, M]
+	prefix, suffix []*ftNode[T, M]
+	middle         *ftTree[T, M]
+	measure        M
+}
+
+func FingerTreeWith[T any, M any](m Measure[T, M]) FingerTree[T, M] {
+	return FingerTree[T, M]{measure: &m}
+}
+
+func FingerTreeFromSlice[T any, M any](m Measure[T, M], src []T) FingerTree[T, M] {
+	t := FingerTreeWith(m)
+	for _, x := range src {
+		t.root = t.measure.pushBack(t.measure.leaf(x), t.root)
+	}
+	return t
+}
+
+func (t FingerTree[T, M]) meas() *Measure[T, M] {
+	if t.measure == nil {
+		panic("finger tree has no measure; create it with FingerTreeWith")
+	}
+	return t.measure
+}
+
+func (t FingerTree[T, M]) with(root *ftTree[T, M]) FingerTree[T, M] {
+	return FingerTree[T, M]{root, t.measure}
+}
+
+func (t FingerTree[T, M]) IsEmpty() bool {
+	return t.root == nil
+}
+
+// Measure is the combined measure of every element, in order.
+func (t FingerTree[T, M]) Measure() M {
+	return t.meas().tree(t.root)
+}
+
+func (t FingerTree[T, M]) PushFront(x T) FingerTree[T, M] {
+	m := t.meas()
+	return t.with(m.pushFront(m.leaf(x), t.root))
+}
+
+func (t FingerTree[T, M]) PushBack(x T) FingerTree[T, M] {
+	m := t.meas()
+	return t.with(m.pushBack(m.leaf(x), t.root))
+}
+
+func (t FingerTree[T, M]) PeekFront() Option[T] {
+	switch {
+	case t.root == nil:
+		return None[T]()
+	case t.root.prefix == nil:
+		return Some(t.root.single.value)
+	}
+	return Some(t.root.prefix[0].value)
+}
+
+func (t FingerTree[T, M]) PeekBack() Option[T] {
+	switch {
+	case t.root == nil:
+		return None[T]()
+	case t.root.prefix == nil:
+		return Some(t.root.single.value)
+	}
+	return Some(t.root.suffix[len(t.root.suffix)-1].value)
+}
+
+// PopFront returns the front element, or None if t is empty, and the tree
+// without it.
+func (t FingerTree[T, M]) PopFront() (Option[T], FingerTree[T, M]) {
+	if t.root == nil {
+		return None[T](), t
+	}
+	head, rest := t.meas().viewFront(t.root)
+	return Some(head.value), t.with(rest)
+}
+
+// PopBack returns the back element, or None if t is empty, and the tree
+// without it.
+func (t FingerTree[T, M]) PopBack() (Option[T], FingerTree[T, M]) {
+	if t.root == nil {
+		return None[T](), t
+	}
+	last, rest := t.meas().viewBack(t.root)
+	return Some(last.value), t.with(rest)
+}
+
+// Concat appends other to t. Both must use equivalent measures.
+func (t FingerTree[T, M]) Concat(other FingerTree[T, M]) FingerTree[T, M] {
+	switch {
+	case t.root == nil:
+		return other
+	case other.root == nil:
+		return t
+	}
+	return t.with(t.meas().concat(t.root, nil, other.root))
+}
+
+// Split divides t before the first element at which p, applied to the
+// measure of the elements up to and including it, holds. p must be monotonic:
+// once true, it stays true as more elements are combined. If p never holds
+// the whole tree is returned on the left.
+func (t FingerTree[T, M]) Split(p func(M) bool) (FingerTree[T, M], FingerTree[T, M]) {
+	if t.root == nil {
+		return t, t
+	}
+	m := t.meas()
+	if !p(m.tree(t.root)) {
+		return t, t.with(nil)
+	}
+	l, x, r := m.split(p, m.Empty, t.root)
+	return t.with(l), t.with(m.pushFront(x, r))
+}
+
+// Lookup returns the element Split would put first on the right, without
+// building either half.
+func (t FingerTree[T, M]) Lookup(p func(M) bool) Option[T] {
+	if t.root == nil {
+		return None[T]()
+	}
+	m := t.meas()
+	if !p(m.tree(t.root)) {
+		return None[T]()
+	}
+	leaf, _ := m.lookup(p, m.Empty, t.root)
+	return Some(leaf.value)
+}
+
+func (t FingerTree[T, M]) Seq() Seq[T] {
+	return func(yield func(T) bool) {
+		t.root.each(yield)
+	}
+}
+
+func (t FingerTree[T, M]) Descending() Seq[T] {
+	return func(yield func(T) bool) {
+		t.root.eachReverse(yield)
+	}
+}
+
+func (t FingerTree[T, M]) ToSlice() []T {
+	return Collect(t.Seq())
+}
+
+func (m *Measure[T, M]) leaf(x T) *ftNode[T, M] {
+	return &ftNode[T, M]{value: x, measure: m.Of(x)}
+}
+
+func (m *Measure[T, M]) node(children ...*ftNode[T, M]) *ftNode[T, M] {
+	return &ftNode[T, M]{children: children, measure: m.digit(children)}
+}
+
+func (m *Measure[T, M]) digit(nodes []*ftNode[T, M]) M {
+	acc := m.Empty
+	for _, n := range nodes {
+		acc = m.Combine(acc, n.measure)
+	}
+	return acc
+}
+
+func (m *Measure[T, M]) tree(t *ftTree[T, M]) M {
+	switch {
+	case t == nil:
+		return m.Empty
+	case t.prefix == nil:
+		return t.single.measure
+	}
+	return t.measure
+}
+
+func (m *Measure[T, M]) deep(prefix []*ftNode[T, M], middle *ftTree[T, M], suffix []*ftNode[T, M]) *ftTree[T, M] {
+	measure := m.Combine(m.Combine(m.digit(prefix), m.tree(middle)), m.digit(suffix))
+	return &ftTree[T, M]{prefix: prefix, middle: middle, suffix: suffix, measure: measure}
+}
+
+func (m *Measure[T, M]) fromDigit(nodes []*ftNode[T, M]) *ftTree[T, M] {
+	var t *ftTree[T, M]
+	for _, n := range nodes {
+		t = m.pushBack(n, t)
+	}
+	return t
+}
+
+// Digits are shared between versions, so they are never appended to in
+// place; every change builds a fresh slice.
+func (m *Measure[T, M]) pushFront(a *ftNode[T, M], t *ftTree[T, M]) *ftTree[T, M] {
+	switch {
+	case t == nil:
+		return &ftTree[T, M]{single: a}
+	case t.prefix == nil:
+		return m.deep([]*ftNode[T, M]{a}, nil, []*ftNode[T, M]{t.single})
+	case len(t.prefix) == 4:
+		p := t.prefix
+		return m.deep([]*ftNode[T, M]{a, p[0]}, m.pushFront(m.node(p[1], p[2], p[3]), t.middle), t.suffix)
+	}
+	return m.deep(slices.Concat([]*ftNode[T, M]{a}, t.prefix), t.middle, t.suffix)
+}
+
+func (m *Measure[T, M]) pushBack(a *ftNode[T, M], t *ftTree[T, M]) *ftTree[T, M] {
+	switch {
+	case t == nil:
+		return &ftTree[T, M]{single: a}
+	case t.prefix == nil:
+		return m.deep([]*ftNode[T, M]{t.single}, nil, []*ftNode[T, M]{a})
+	case len(t.suffix) == 4:
+		s := t.suffix
+		return m.deep(t.prefix, m.pushBack(m.node(s[0], s[1], s[2]), t.middle), []*ftNode[T, M]{s[3], a})
+	}
+	return m.deep(t.prefix, t.middle, slices.Concat(t.suffix, []*ftNode[T, M]{a}))
+}
+
+// viewFront splits a non-empty tree into its first node and the rest.
+func (m *Measure[T, M]) viewFront(t *ftTree[T, M]) (*ftNode[T, M], *ftTree[T, M]) {
+	if t.prefix == nil {
+		return t.single, nil
+	}
+	return t.prefix[0], m.deepFront(t.prefix[1:], t.middle, t.suffix)
+}
+
+func (m *Measure[T, M]) viewBack(t *ftTree[T, M]) (*ftNode[T, M], *ftTree[T, M]) {
+	if t.prefix == nil {
+		return t.single, nil
+	}
+	n := len(t.suffix) - 1
+	return t.suffix[n], m.deepBack(t.prefix, t.middle, t.suffix[:n])
+}
+
+// deepFront is deep for a possibly empty prefix, refilled from the middle.
+func (m *Measure[T, M]) deepFront(prefix []*ftNode[T, M], middle *ftTree[T, M], suffix []*ftNode[T, M]) *ftTree[T, M] {
+	switch {
+	case len(prefix) > 0:
+		return m.deep(prefix, middle, suffix)
+	case middle == nil:
+		return m.fromDigit(suffix)
+	}
+	head, rest := m.viewFront(middle)
+	return m.deep(head.children, rest, suffix)
+}
+
+// deepBack is deep for a possibly empty suffix, refilled from the middle.
+func (m *Measure[T, M]) deepBack(prefix []*ftNode[T, M], middle *ftTree[T, M], suffix []*ftNode[T, M]) *ftTree[T, M] {
+	switch {
+	case len(suffix) > 0:
+		return m.deep(prefix, middle, suffix)
+	case middle == nil:
+		return m.fromDigit(prefix)
+	}
+	last, rest := m.viewBack(middle)
+	return m.deep(prefix, rest, last.children)
+}
+
+// concat joins l, the nodes mid and r, pushing the inner digits down a level
+// as 2-3 nodes.
+func (m *Measure[T, M]) concat(l *ftTree[T, M], mid []*ftNode[T, M], r *ftTree[T, M]) *ftTree[T, M] {
+	switch {
+	case l == nil:
+		for i := len(mid) - 1; i >= 0; i-- {
+			r = m.pushFront(mid[i], r)
+		}
+		return r
+	case r == nil:
+		for _, n := range mid {
+			l = m.pushBack(n, l)
+		}
+		return l
+	case l.prefix == nil:
+		return m.pushFront(l.single, m.concat(nil, mid, r))
+	case r.prefix == nil:
+		return m.pushBack(r.single, m.concat(l, mid, nil))
+	}
+	inner := m.nodes(slices.Concat(l.suffix, mid, r.prefix))
+	return m.deep(l.prefix, m.concat(l.middle, inner, r.middle), r.suffix)
+}
+
+// nodes groups two or more nodes into 2-3 nodes, preferring threes.
+func (m *Measure[T, M]) nodes(xs []*ftNode[T, M]) []*ftNode[T, M] {
+	var result []*ftNode[T, M]
+	for len(xs) > 0 {
+		switch len(xs) {
+		case 2:
+			return append(result, m.node(xs[0], xs[1]))
+		case 4:
+			return append(result, m.node(xs[0], xs[1]), m.node(xs[2], xs[3]))
+		}
+		result = append(result, m.node(xs[0], xs[1], xs[2]))
+		xs = xs[3:]
+	}
+	return result
+}
+
+// split finds the node at which p, applied to acc combined with the measures
+// before and including it, first holds. p must hold for acc combined with
+// the whole of t, which must not be empty.
+func (m *Measure[T, M]) split(p func(M) bool, acc M, t *ftTree[T, M]) (*ftTree[T, M], *ftNode[T, M], *ftTree[T, M]) {
+	if t.prefix == nil {
+		return nil, t.single, nil
+	}
+	afterPrefix := m.Combine(acc, m.digit(t.prefix))
+	if p(afterPrefix) {
+		i, _ := m.searchDigit(p, acc, t.prefix)
+		return m.fromDigit(t.prefix[:i]), t.prefix[i], m.deepFront(t.prefix[i+1:], t.middle, t.suffix)
+	}
+	afterMiddle := m.Combine(afterPrefix, m.tree(t.middle))
+	if p(afterMiddle) {
+		ml, node, mr := m.split(p, afterPrefix, t.middle)
+		i, _ := m.searchDigit(p, m.Combine(afterPrefix, m.tree(ml)), node.children)
+		return m.deepBack(t.prefix, ml, node.children[:i]), node.children[i], m.deepFront(node.children[i+1:], mr, t.suffix)
+	}
+	i, _ := m.searchDigit(p, afterMiddle, t.suffix)
+	return m.deepBack(t.prefix, t.middle, t.suffix[:i]), t.suffix[i], m.fromDigit(t.suffix[i+1:])
+}
+
+// lookup is split without rebuilding: it returns the leaf split would find
+// and the measure of everything before it.
+func (m *Measure[T, M]) lookup(p func(M) bool, acc M, t *ftTree[T, M]) (*ftNode[T, M], M) {
+	if t.prefix == nil {
+		return t.single, acc
+	}
+	afterPrefix := m.Combine(acc, m.digit(t.prefix))
+	if p(afterPrefix) {
+		i, before := m.searchDigit(p, acc, t.prefix)
+		return t.prefix[i], before
+	}
+	afterMiddle := m.Combine(afterPrefix, m.tree(t.middle))
+	if p(afterMiddle) {
+		node, before := m.lookup(p, afterPrefix, t.middle)
+		for node.children != nil {
+			var i int
+			i, before = m.searchDigit(p, before, node.children)
+			node = node.children[i]
+		}
+		return node, before
+	}
+	i, before := m.searchDigit(p, afterMiddle, t.suffix)
+	return t.suffix[i], before
+}
+
+// searchDigit returns the index of the first node at which p holds, or the
+// last node if it holds nowhere, and the measure before that node.
+func (m *Measure[T, M]) searchDigit(p func(M) bool, acc M, nodes []*ftNode[T, M]) (int, M) {
+	last := len(nodes) - 1
+	for i, n := range nodes[:last] {
+		next := m.Combine(acc, n.measure)
+		if p(next) {
+			return i, acc
+		}
+		acc = next
+	}
+	return last, acc
+}
+
+func (n *ftNode[T, M]) each(yield func(T) bool) bool {
+	if n.children == nil {
+		return yield(n.value)
+	}
+	for _, c := range n.children {
+		if !c.each(yield) {
+			return false
+		}
+	}
+	return true
+}
+
+func (n *ftNode[T, M]) eachReverse(yield func(T) bool) bool {
+	if n.children == nil {
+		return yield(n.value)
+	}
+	for _, c := range slices.Backward(n.children) {
+		if !c.eachReverse(yield) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *ftTree[T, M]) each(yield func(T) bool) bool {
+	switch {
+	case t == nil:
+		return true
+	case t.prefix == nil:
+		return t.single.each(yield)
+	}
+	for _, n := range t.prefix {
+		if !n.each(yield) {
+			return false
+		}
+	}
+	if !t.middle.each(yield) {
+		return false
+	}
+	for _, n := range t.suffix {
+		if !n.each(yield) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *ftTree[T, M]) eachReverse(yield func(T) bool) bool {
+	switch {
+	case t == nil:
+		return true
+	case t.prefix == nil:
+		return t.single.eachReverse(yield)
+	}
+	for _, n := range slices.Backward(t.suffix) {
+		if !n.eachReverse(yield) {
+			return false
+		}
+	}
+	if !t.middle.eachReverse(yield) {
+		return false
+	}
+	for _, n := range slices.Backward(t.prefix) {
+		if !n.eachReverse(yield) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,129 @@
+package functionalgo
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// checkFingerTree verifies digit and node arities, that every leaf sits at
+// the depth implied by its level, and that cached measures are correct.
+func checkFingerTree[T any, M any](t *testing.T, ft FingerTree[T, M]) {
+	t.Helper()
+	if ft.root == nil {
+		return
+	}
+	m := ft.measure
+	var checkNode func(n *ftNode[T, M], depth int) M
+	checkNode = func(n *ftNode[T, M], depth int) M {
+		if depth == 0 {
+			if n.children != nil {
+				t.Fatalf("Expected a leaf at depth 0")
+			}
+			return m.Of(n.value)
+		}
+		if len(n.children) < 2 || len(n.children) > 3 {
+			t.Fatalf("Expected 2 or 3 children, got %d", len(n.children))
+		}
+		acc := m.Empty
+		for _, c := range n.children {
+			acc = m.Combine(acc, checkNode(c, depth-1))
+		}
+		if !reflect.DeepEqual(acc, n.measure) {
+			t.Fatalf("Expected node measure %v, got %v", acc, n.measure)
+		}
+		return acc
+	}
+	var checkTree func(tr *ftTree[T, M], depth int) M
+	checkTree = func(tr *ftTree[T, M], depth int) M {
+		switch {
+		case tr == nil:
+			return m.Empty
+		case tr.prefix == nil:
+			return checkNode(tr.single, depth)
+		}
+		acc := m.Empty
+		for _, digit := range [][]*ftNode[T, M]{tr.prefix, nil, tr.suffix} {
+			if digit == nil {
+				acc = m.Combine(acc, checkTree(tr.middle, depth+1))
+				continue
+			}
+			if len(digit) > 4 {
+				t.Fatalf("Expected at most 4 nodes in a digit, got %d", len(digit))
+			}
+			for _, n := range digit {
+				acc = m.Combine(acc, checkNode(n, depth))
+			}
+		}
+		if len(tr.prefix) == 0 || len(tr.suffix) == 0 {
+			t.Fatalf("Expected non-empty digits")
+		}
+		if !reflect.DeepEqual(acc, tr.measure) {
+			t.Fatalf("Expected tree measure %v, got %v", acc, tr.measure)
+		}
+		return acc
+	}
+	checkTree(ft.root, 0)
+}
+
+// maxMeasure measures a run of priorities by its maximum, turning a finger
+// tree into a priority queue.
+var maxMeasure = Measure[int, int]{
+	Empty:   -1,
+	Combine: func(a, b int) int { return max(a, b) },
+	Of:      func(x int) int { return x },
+}
+
+func TestFingerTreeCustomMeasure(t *testing.T) {
+	src := []int{3, 9, 1, 7, 9, 4, 2, 8}
+	ft := FingerTreeFromSlice(maxMeasure, src)
+	checkFingerTree(t, ft)
+	if ft.Measure() != 9 {
+		t.Errorf("Expected measure 9, got %d", ft.Measure())
+	}
+
+	atLeast := func(n int) func(int) bool { return func(m int) bool { return m >= n } }
+	l, r := ft.Split(atLeast(8))
+	if !reflect.DeepEqual(l.ToSlice(), []int{3}) || !reflect.DeepEqual(r.ToSlice(), []int{9, 1, 7, 9, 4, 2, 8}) {
+		t.Errorf("Expected [3] and the rest, got %v and %v", l.ToSlice(), r.ToSlice())
+	}
+	if result := ft.Lookup(atLeast(8)); result != Some(9) {
+		t.Errorf("Expected Some(9), got %v", result)
+	}
+
+	t.Run("predicate never holding", func(t *testing.T) {
+		l, r := ft.Split(atLeast(10))
+		if !reflect.DeepEqual(l.ToSlice(), src) || !r.IsEmpty() {
+			t.Errorf("Expected everything on the left, got %v and %v", l.ToSlice(), r.ToSlice())
+		}
+		if ft.Lookup(atLeast(10)).IsSome() {
+			t.Errorf("Expected None")
+		}
+	})
+
+	t.Run("zero value without measure panics", func(t *testing.T) {
+		expectPanic(t, "PushBack", func() { FingerTree[int, int]{}.PushBack(1) })
+	})
+}
+
+func TestFingerTreeLarge(t *testing.T) {
+	src := Range(0, 2000, 1)
+	ft := FingerTreeFromSlice(sizeMeasure[int](), src)
+	checkFingerTree(t, ft)
+	for _, i := range []int{0, 1, 17, 999, 1998, 1999} {
+		l, r := ft.Split(func(n int) bool { return n > i })
+		checkFingerTree(t, l)
+		checkFingerTree(t, r)
+		if !slices.Equal(l.ToSlice(), src[:i]) || !slices.Equal(r.ToSlice(), src[i:]) {
+			t.Fatalf("Expected a split at %d", i)
+		}
+		joined := l.Concat(r)
+		checkFingerTree(t, joined)
+		if !slices.Equal(joined.ToSlice(), src) {
+			t.Fatalf("Expected Concat to undo the split at %d", i)
+		}
+	}
+	if result := Collect(ft.Descending()); !slices.Equal(result, Reverse(src)) {
+		t.Errorf("Expected descending order")
+	}
+}
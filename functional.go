@@ -1,14 +1,14 @@
 package functionalgo
 
 type (
-	Tuple[A any, B any] struct {
-		fst A
-		snd B
-	}
 	GuardS[T any] struct {
 		cond bool
 		fn   func() T
 	}
+	// GeneratorS was a placeholder for lazy generators and never had any
+	// methods.
+	//
+	// Deprecated: Use Seq, whose combinators cover what it was meant for.
 	GeneratorS struct {
 	}
 )
@@ -29,20 +29,6 @@ func Guards[T any](guards ...GuardS[T]) T {
 	panic("not exhaustive guards")
 }
 
-func Take[A ~[]any](src A, num int) A {
-	return Guards(
-		Guard(num > len(src), func() A { return src }),
-		Guard(true, func() A { return src[:num] }),
-	)
-}
-
-func Drop[A ~[]any](src A, num int) A {
-	return Guards(
-		Guard(num > len(src), func() A { return src[:0] }),
-		Guard(true, func() A { return src[num+1:] }),
-	)
-}
-
 func Head[A any, B ~[]A](src B) A {
 	return Guards(
 		Guard(len(src) == 0, func() A { panic("cannot take head of empty list") }),
@@ -58,19 +44,21 @@ func Tail[A any, B ~[]A](src B) B {
 }
 
 func Map[A any, B any](fn func(A) B, src []A) (result []B) {
-	return Foldl(func(b []B, a A) []B {
-		return append(b, fn(a))
-	}, []B{}, src)
+	result = make([]B, len(src))
+	for i, v := range src {
+		result[i] = fn(v)
+	}
+	return result
 }
 
 func Filter[A any](fn func(A) bool, src []A) (result []A) {
-	return Foldl(func(b []A, a A) []A {
-		if fn(a) {
-			return append(b, a)
-		} else {
-			return b
+	result = []A{}
+	for _, v := range src {
+		if fn(v) {
+			result = append(result, v)
 		}
-	}, []A{}, src)
+	}
+	return result
 }
 
 func Compose[A any, B any, C any](fnB func(B) C, fnA func(A) B) func(A) C {
@@ -80,41 +68,33 @@ func Compose[A any, B any, C any](fnB func(B) C, fnA func(A) B) func(A) C {
 }
 
 func Foldl[A any, B any](fn func(B, A) B, acc B, src []A) B {
-	return Guards(
-		Guard(len(src) == 0, func() B { return acc }),
-		Guard(true, func() B { return Foldl(fn, fn(acc, Head(src)), Tail(src)) }),
-	)
+	for _, v := range src {
+		acc = fn(acc, v)
+	}
+	return acc
 }
 
 func Foldr[A any, B any](fn func(A, B) B, acc B, src []A) B {
-	return Guards(
-		Guard(len(src) == 0, func() B { return acc }),
-		Guard(len(src) == 1, func() B { return fn(Head(src), acc) }),
-		Guard(true, func() B { return fn(Head(src), Foldr(fn, acc, Tail(src))) }),
-	)
+	for i := len(src) - 1; i >= 0; i-- {
+		acc = fn(src[i], acc)
+	}
+	return acc
 }
 
 func ZipWith[A any, B any, C any](fn func(A, B) C, srcA []A, srcB []B) (result []C) {
-	return Guards(
-		Guard(len(srcA) == 0 || len(srcB) == 0, func() []C { return nil }),
-		Guard(true, func() []C {
-			return append(append(result, fn(Head(srcA), Head(srcB))), ZipWith(fn, Tail(srcA), Tail(srcB))...)
-		}),
-	)
+	n := min(len(srcA), len(srcB))
+	if n == 0 {
+		return nil
+	}
+	result = make([]C, n)
+	for i := range n {
+		result[i] = fn(srcA[i], srcB[i])
+	}
+	return result
 }
 
 func Zip[A any, B any](srcA []A, srcB []B) (result []Tuple[A, B]) {
-	return ZipWith(func(a A, b B) Tuple[A, B] {
-		return Tuple[A, B]{fst: a, snd: b}
-	}, srcA, srcB)
-}
-
-func Fst[A any, B any](t Tuple[A, B]) A {
-	return t.fst
-}
-
-func Snd[A any, B any](t Tuple[A, B]) B {
-	return t.snd
+	return ZipWith(MkTuple[A, B], srcA, srcB)
 }
 
 func Last[A any](src []A) A {
@@ -125,21 +105,21 @@ func Last[A any](src []A) A {
 }
 
 func Any[A any](fn func(A) bool, src []A) bool {
-	return Guards(
-		Guard(len(src) == 0, func() bool { return false }),
-		Guard(len(src) > 0 && fn(Head(src)), func() bool { return true }),
-		Guard(len(src) > 0, func() bool { return Any(fn, Tail(src)) }),
-		Guard(true, func() bool { return false }), // Fallback, sollte nie erreicht werden
-	)
+	for _, v := range src {
+		if fn(v) {
+			return true
+		}
+	}
+	return false
 }
 
 func All[A any](fn func(A) bool, src []A) bool {
-	return Guards(
-		Guard(len(src) == 0, func() bool { return true }),
-		Guard(len(src) > 0 && !fn(Head(src)), func() bool { return false }),
-		Guard(len(src) > 0, func() bool { return All(fn, Tail(src)) }),
-		Guard(true, func() bool { return true }), // Fallback, sollte nie erreicht werden
-	)
+	for _, v := range src {
+		if !fn(v) {
+			return false
+		}
+	}
+	return true
 }
 
 func Sum[A int8 | int16 | int32 | int64 | int | float32 | float64](src []A) A {
@@ -162,38 +142,77 @@ func FlattenWith[A comparable, B any, C any](fn func(A, B) C, src map[A]B) (resu
 }
 
 func Flatten[A comparable, B any](src map[A]B) (result []Tuple[A, B]) {
-	return FlattenWith(func(a A, b B) Tuple[A, B] {
-		return Tuple[A, B]{fst: a, snd: b}
-	}, src)
+	return FlattenWith(MkTuple[A, B], src)
 }
 
-func Maximum[A comparable](src []A) A {
-	return Guards(
-		Guard(len(src) == 0, func() A { panic("called maximum on empty list") }),
-		Guard(true, func() A {
-			return Foldl(func(acc A, x A) A {
-				if Compare(acc, x) == GT {
-					return acc
-				} else {
-					return x
-				}
-			}, src[0], src)
-		}),
-	)
+func Maximum[A any](src []A) A {
+	return MaximumBy(Compare[A], src)
+}
 
+func Minimum[A any](src []A) A {
+	return MinimumBy(Compare[A], src)
 }
 
-func Minimum[A comparable](src []A) A {
-	return Guards(
-		Guard(len(src) == 0, func() A { panic("called minimum on empty list") }),
-		Guard(true, func() A {
-			return Foldl(func(acc A, x A) A {
-				if Compare(acc, x) == LT {
-					return acc
-				} else {
-					return x
-				}
-			}, src[0], src)
-		}),
-	)
+// MaximumOrd and MinimumOrd are the compile-time checked counterparts of
+// Maximum and Minimum.
+func MaximumOrd[A Ord](src []A) A {
+	return MaximumBy(CompareOrd[A], src)
+}
+
+func MinimumOrd[A Ord](src []A) A {
+	return MinimumBy(CompareOrd[A], src)
+}
+
+func MaximumBy[A any](ord Ordering[A], src []A) A {
+	if len(src) == 0 {
+		panic("called maximum on empty list")
+	}
+	return Foldl(func(acc A, x A) A {
+		if ord(acc, x) == GT {
+			return acc
+		}
+		return x
+	}, src[0], src[1:])
+}
+
+func MinimumBy[A any](ord Ordering[A], src []A) A {
+	if len(src) == 0 {
+		panic("called minimum on empty list")
+	}
+	return Foldl(func(acc A, x A) A {
+		if ord(x, acc) == LT {
+			return x
+		}
+		return acc
+	}, src[0], src[1:])
+}
+
+// ArgMax returns the element of src for which fn is greatest, the last such
+// element on ties as with MaximumBy. fn is called once per element.
+func ArgMax[A any, B any](fn func(A) B, src []A) A {
+	if len(src) == 0 {
+		panic("called argmax on empty list")
+	}
+	best, bestKey := src[0], fn(src[0])
+	for _, x := range src[1:] {
+		if key := fn(x); Compare(bestKey, key) != GT {
+			best, bestKey = x, key
+		}
+	}
+	return best
+}
+
+// ArgMin returns the element of src for which fn is least, the first such
+// element on ties as with MinimumBy. fn is called once per element.
+func ArgMin[A any, B any](fn func(A) B, src []A) A {
+	if len(src) == 0 {
+		panic("called argmin on empty list")
+	}
+	best, bestKey := src[0], fn(src[0])
+	for _, x := range src[1:] {
+		if key := fn(x); Compare(key, bestKey) == LT {
+			best, bestKey = x, key
+		}
+	}
+	return best
 }
@@ -65,6 +65,12 @@ func TestTake(t *testing.T) {
 			num:      2,
 			expected: []any{},
 		},
+		{
+			name:     "take negative count",
+			input:    []any{1, 2, 3},
+			num:      -1,
+			expected: []any{},
+		},
 	}
 
 	for _, tc := range tests {
@@ -90,7 +96,7 @@ func TestDrop(t *testing.T) {
 			name:     "drop fewer than length",
 			input:    []any{1, 2, 3, 4, 5},
 			num:      2,
-			expected: []any{4, 5},
+			expected: []any{3, 4, 5},
 		},
 		{
 			name:     "drop more than length",
@@ -102,7 +108,13 @@ func TestDrop(t *testing.T) {
 			name:     "drop zero elements",
 			input:    []any{1, 2, 3},
 			num:      0,
-			expected: []any{2, 3},
+			expected: []any{1, 2, 3},
+		},
+		{
+			name:     "drop negative count",
+			input:    []any{1, 2, 3},
+			num:      -1,
+			expected: []any{1, 2, 3},
 		},
 		{
 			name:     "drop from empty slice",
@@ -554,8 +566,7 @@ func TestCompare(t *testing.T) {
 		}
 	})
 
-	// Test custom struct with equality only
-	t.Run("compare custom struct with equality", func(t *testing.T) {
+	t.Run("compare custom struct field by field", func(t *testing.T) {
 		type Person struct {
 			Name string
 			Age  int
@@ -570,10 +581,10 @@ func TestCompare(t *testing.T) {
 			t.Errorf("Expected Compare(p1, p2) to be EQ for identical structs")
 		}
 
-		// Different values should return consistently (we've chosen GT for non-equal values)
+		// Different values are ordered by their first differing field
 		result := Compare(p1, p3)
-		if result != GT {
-			t.Errorf("Expected Compare(p1, p3) to return GT for different structs, got %v", result)
+		if result != LT {
+			t.Errorf("Expected Compare(p1, p3) to return LT for different structs, got %v", result)
 		}
 	})
 }
@@ -806,6 +817,16 @@ func TestMaximum(t *testing.T) {
 	})
 }
 
+func TestMaximumOrdMinimumOrd(t *testing.T) {
+	if result := MaximumOrd([]celsius{3, 41.5, -2}); result != 41.5 {
+		t.Errorf("Expected 41.5, got %v", result)
+	}
+	if result := MinimumOrd([]string{"pear", "apple", "fig"}); result != "apple" {
+		t.Errorf("Expected apple, got %v", result)
+	}
+	expectPanic(t, "MaximumOrd of empty slice", func() { MaximumOrd([]int{}) })
+}
+
 func TestMinimum(t *testing.T) {
 	t.Run("minimum of integers", func(t *testing.T) {
 		result := Minimum([]int{5, 1, 3, 9, 2})
@@ -841,6 +862,36 @@ func TestMinimum(t *testing.T) {
 	})
 }
 
+func TestArgMaxArgMin(t *testing.T) {
+	words := []string{"go", "rust", "haskell", "zig", "clojure", "c"}
+	length := func(s string) int { return len(s) }
+
+	t.Run("argmax keeps the last tie", func(t *testing.T) {
+		if result := ArgMax(length, words); result != "clojure" {
+			t.Errorf("Expected clojure, got %v", result)
+		}
+	})
+
+	t.Run("argmin keeps the first tie", func(t *testing.T) {
+		if result := ArgMin(length, []string{"go", "c", "d"}); result != "c" {
+			t.Errorf("Expected c, got %v", result)
+		}
+	})
+
+	t.Run("key function called once per element", func(t *testing.T) {
+		calls := 0
+		ArgMax(func(s string) int { calls++; return len(s) }, words)
+		if calls != len(words) {
+			t.Errorf("Expected %d calls, got %d", len(words), calls)
+		}
+	})
+
+	t.Run("empty slice panics", func(t *testing.T) {
+		expectPanic(t, "ArgMax", func() { ArgMax(length, nil) })
+		expectPanic(t, "ArgMin", func() { ArgMin(length, nil) })
+	})
+}
+
 func TestGuards(t *testing.T) {
 	t.Run("first guard matches", func(t *testing.T) {
 		result := Guards(
@@ -904,3 +955,37 @@ func TestGuards(t *testing.T) {
 		}
 	})
 }
+
+func TestLargeInputs(t *testing.T) {
+	src := make([]int, 1_000_000)
+	for i := range src {
+		src[i] = 1
+	}
+
+	t.Run("foldl", func(t *testing.T) {
+		if result := Foldl(func(acc, x int) int { return acc + x }, 0, src); result != len(src) {
+			t.Errorf("Expected %v, got %v", len(src), result)
+		}
+	})
+
+	t.Run("foldr", func(t *testing.T) {
+		if result := Foldr(func(x, acc int) int { return acc + x }, 0, src); result != len(src) {
+			t.Errorf("Expected %v, got %v", len(src), result)
+		}
+	})
+
+	t.Run("zipWith", func(t *testing.T) {
+		if result := ZipWith(func(a, b int) int { return a + b }, src, src); len(result) != len(src) || result[len(src)-1] != 2 {
+			t.Errorf("Expected %v elements of 2, got %v elements", len(src), len(result))
+		}
+	})
+
+	t.Run("any and all", func(t *testing.T) {
+		if Any(func(x int) bool { return x != 1 }, src) {
+			t.Errorf("Expected Any to return false")
+		}
+		if !All(func(x int) bool { return x == 1 }, src) {
+			t.Errorf("Expected All to return true")
+		}
+	})
+}
@@ -0,0 +1,261 @@
+package functionalgo
+
+// The slice groupers below return views of src with clipped capacity, like
+// the sublist functions. Their Seq counterparts cannot look back at earlier
+// elements, so every group they yield is a fresh slice.
+
+func Partition[A any](fn func(A) bool, src []A) ([]A, []A) {
+	matching, rest := []A{}, []A{}
+	for _, v := range src {
+		if fn(v) {
+			matching = append(matching, v)
+		} else {
+			rest = append(rest, v)
+		}
+	}
+	return matching, rest
+}
+
+// GroupBy splits src into runs of adjacent elements that are eq to the first
+// element of their run.
+func GroupBy[A any](eq func(A, A) bool, src []A) [][]A {
+	result := [][]A{}
+	for start := 0; start < len(src); {
+		end := start + 1
+		for end < len(src) && eq(src[start], src[end]) {
+			end++
+		}
+		result = append(result, src[start:end:end])
+		start = end
+	}
+	return result
+}
+
+// GroupOn splits src into runs of adjacent elements with the same key.
+func GroupOn[A any, K comparable](key func(A) K, src []A) [][]A {
+	return GroupBy(func(a, b A) bool { return key(a) == key(b) }, src)
+}
+
+// GroupInto collects all elements with the same key, adjacent or not, keeping
+// their relative order.
+func GroupInto[A any, K comparable](key func(A) K, src []A) map[K][]A {
+	result := map[K][]A{}
+	for _, v := range src {
+		k := key(v)
+		result[k] = append(result[k], v)
+	}
+	return result
+}
+
+func ChunksOf[A any](size int, src []A) [][]A {
+	if size <= 0 {
+		panic("chunk size must be positive")
+	}
+	result := make([][]A, 0, (len(src)+size-1)/size)
+	for start := 0; start < len(src); start += size {
+		end := min(start+size, len(src))
+		result = append(result, src[start:end:end])
+	}
+	return result
+}
+
+// SlidingWindows returns every window of size elements, starting a new window
+// every step elements. Trailing elements that do not fill a window are
+// dropped.
+func SlidingWindows[A any](size, step int, src []A) [][]A {
+	if size <= 0 || step <= 0 {
+		panic("window size and step must be positive")
+	}
+	result := [][]A{}
+	for start := 0; start+size <= len(src); start += step {
+		result = append(result, src[start:start+size:start+size])
+	}
+	return result
+}
+
+// SplitOn splits src around every non-overlapping occurrence of sep, scanning
+// from the left. Separators at the ends produce empty groups.
+func SplitOn[A comparable](sep []A, src []A) [][]A {
+	if len(sep) == 0 {
+		panic("separator must not be empty")
+	}
+	result := [][]A{}
+	start := 0
+	for i := 0; i+len(sep) <= len(src); {
+		if hasPrefix(src[i:], sep) {
+			result = append(result, src[start:i:i])
+			i += len(sep)
+			start = i
+		} else {
+			i++
+		}
+	}
+	return append(result, src[start:len(src):len(src)])
+}
+
+func Intersperse[A any](sep A, src []A) []A {
+	if len(src) == 0 {
+		return []A{}
+	}
+	result := make([]A, 0, 2*len(src)-1)
+	for i, v := range src {
+		if i > 0 {
+			result = append(result, sep)
+		}
+		result = append(result, v)
+	}
+	return result
+}
+
+func Intercalate[A any](sep []A, src [][]A) []A {
+	result := []A{}
+	for i, v := range src {
+		if i > 0 {
+			result = append(result, sep...)
+		}
+		result = append(result, v...)
+	}
+	return result
+}
+
+func GroupBySeq[A any](eq func(A, A) bool, seq Seq[A]) Seq[[]A] {
+	return func(yield func([]A) bool) {
+		var group []A
+		for v := range seq {
+			if len(group) > 0 && !eq(group[0], v) {
+				if !yield(group) {
+					return
+				}
+				group = nil
+			}
+			group = append(group, v)
+		}
+		if len(group) > 0 {
+			yield(group)
+		}
+	}
+}
+
+func GroupOnSeq[A any, K comparable](key func(A) K, seq Seq[A]) Seq[[]A] {
+	return GroupBySeq(func(a, b A) bool { return key(a) == key(b) }, seq)
+}
+
+// PartitionSeq returns the elements of seq that satisfy fn and those that do
+// not, as two lazy sequences. Each of them ranges over seq and calls fn on
+// every element, so seq must be safe to range over more than once; for a
+// single-use sequence call Partition on Collect(seq) instead.
+func PartitionSeq[A any](fn func(A) bool, seq Seq[A]) (Seq[A], Seq[A]) {
+	return FilterSeq(fn, seq), FilterSeq(func(v A) bool { return !fn(v) }, seq)
+}
+
+// GroupIntoSeq consumes seq, collecting all elements with the same key in the
+// order they were yielded.
+func GroupIntoSeq[A any, K comparable](key func(A) K, seq Seq[A]) map[K][]A {
+	result := map[K][]A{}
+	for v := range seq {
+		k := key(v)
+		result[k] = append(result[k], v)
+	}
+	return result
+}
+
+func ChunksOfSeq[A any](size int, seq Seq[A]) Seq[[]A] {
+	return ChunkSeq(size, seq)
+}
+
+func SlidingWindowsSeq[A any](size, step int, seq Seq[A]) Seq[[]A] {
+	if size <= 0 || step <= 0 {
+		panic("window size and step must be positive")
+	}
+	return func(yield func([]A) bool) {
+		var window []A
+		skip := 0
+		for v := range seq {
+			if skip > 0 {
+				skip--
+				continue
+			}
+			window = append(window, v)
+			if len(window) < size {
+				continue
+			}
+			if !yield(window) {
+				return
+			}
+			if step < size {
+				window = append([]A(nil), window[step:]...)
+			} else {
+				window = nil
+				skip = step - size
+			}
+		}
+	}
+}
+
+func SplitOnSeq[A comparable](sep []A, seq Seq[A]) Seq[[]A] {
+	if len(sep) == 0 {
+		panic("separator must not be empty")
+	}
+	return func(yield func([]A) bool) {
+		group := []A{}
+		for v := range seq {
+			group = append(group, v)
+			end := len(group) - len(sep)
+			if end >= 0 && hasPrefix(group[end:], sep) {
+				if !yield(group[:end:end]) {
+					return
+				}
+				group = []A{}
+			}
+		}
+		yield(group)
+	}
+}
+
+func IntersperseSeq[A any](sep A, seq Seq[A]) Seq[A] {
+	return func(yield func(A) bool) {
+		first := true
+		for v := range seq {
+			if !first && !yield(sep) {
+				return
+			}
+			first = false
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func IntercalateSeq[A any](sep []A, seq Seq[[]A]) Seq[A] {
+	return func(yield func(A) bool) {
+		first := true
+		for group := range seq {
+			if !first {
+				for _, v := range sep {
+					if !yield(v) {
+						return
+					}
+				}
+			}
+			first = false
+			for _, v := range group {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func hasPrefix[A comparable](src, prefix []A) bool {
+	if len(prefix) > len(src) {
+		return false
+	}
+	for i, v := range prefix {
+		if src[i] != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,249 @@
+package functionalgo
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPartition(t *testing.T) {
+	evens, odds := Partition(func(x int) bool { return x%2 == 0 }, []int{1, 2, 3, 4, 5})
+	if !reflect.DeepEqual(evens, []int{2, 4}) || !reflect.DeepEqual(odds, []int{1, 3, 5}) {
+		t.Errorf("Expected [2 4] [1 3 5], got %v %v", evens, odds)
+	}
+
+	evens, odds = Partition(func(x int) bool { return x%2 == 0 }, []int{})
+	if !reflect.DeepEqual(evens, []int{}) || !reflect.DeepEqual(odds, []int{}) {
+		t.Errorf("Expected [] [], got %v %v", evens, odds)
+	}
+
+	t.Run("seq", func(t *testing.T) {
+		evens, odds := PartitionSeq(func(x int) bool { return x%2 == 0 }, FromSlice([]int{1, 2, 3, 4, 5}))
+		if e, o := Collect(evens), Collect(odds); !reflect.DeepEqual(e, []int{2, 4}) || !reflect.DeepEqual(o, []int{1, 3, 5}) {
+			t.Errorf("Expected [2 4] [1 3 5], got %v %v", e, o)
+		}
+	})
+
+	t.Run("seq is lazy", func(t *testing.T) {
+		calls := 0
+		evens, _ := PartitionSeq(func(x int) bool { calls++; return x%2 == 0 }, Iterate(func(x int) int { return x + 1 }, 0))
+		if result := Collect(TakeSeq(evens, 3)); !reflect.DeepEqual(result, []int{0, 2, 4}) || calls != 5 {
+			t.Errorf("Expected [0 2 4] after 5 calls, got %v after %d", result, calls)
+		}
+	})
+}
+
+func TestGroupBy(t *testing.T) {
+	t.Run("adjacent equal elements", func(t *testing.T) {
+		result := GroupBy(func(a, b int) bool { return a == b }, []int{1, 1, 2, 3, 3, 3, 1})
+		expected := [][]int{{1, 1}, {2}, {3, 3, 3}, {1}}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("compares against first element of group", func(t *testing.T) {
+		result := GroupBy(func(a, b int) bool { return b-a < 3 }, []int{1, 2, 3, 4, 5, 6})
+		expected := [][]int{{1, 2, 3}, {4, 5, 6}}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		result := GroupBy(func(a, b int) bool { return a == b }, []int{})
+		if !reflect.DeepEqual(result, [][]int{}) {
+			t.Errorf("Expected [], got %v", result)
+		}
+	})
+}
+
+func TestGroupOn(t *testing.T) {
+	words := []string{"apple", "avocado", "banana", "blueberry", "apricot"}
+	result := GroupOn(func(s string) byte { return s[0] }, words)
+	expected := [][]string{{"apple", "avocado"}, {"banana", "blueberry"}, {"apricot"}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestGroupInto(t *testing.T) {
+	words := []string{"apple", "avocado", "banana", "blueberry", "apricot"}
+	result := GroupInto(func(s string) byte { return s[0] }, words)
+	expected := map[byte][]string{
+		'a': {"apple", "avocado", "apricot"},
+		'b': {"banana", "blueberry"},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	t.Run("seq", func(t *testing.T) {
+		result := GroupIntoSeq(func(s string) byte { return s[0] }, FromSlice(words))
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+		if result := GroupIntoSeq(func(s string) byte { return s[0] }, FromSlice([]string{})); !reflect.DeepEqual(result, map[byte][]string{}) {
+			t.Errorf("Expected an empty map, got %v", result)
+		}
+	})
+}
+
+func TestChunksOf(t *testing.T) {
+	t.Run("uneven chunks", func(t *testing.T) {
+		result := ChunksOf(2, []int{1, 2, 3, 4, 5})
+		expected := [][]int{{1, 2}, {3, 4}, {5}}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		result := ChunksOf(3, []int{})
+		if !reflect.DeepEqual(result, [][]int{}) {
+			t.Errorf("Expected [], got %v", result)
+		}
+	})
+
+	t.Run("appending to a chunk does not clobber the next", func(t *testing.T) {
+		chunks := ChunksOf(2, []int{1, 2, 3, 4})
+		_ = append(chunks[0], 99)
+		if chunks[1][0] != 3 {
+			t.Errorf("Expected 3, got %v", chunks[1][0])
+		}
+	})
+
+	t.Run("non-positive size panics", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("Expected ChunksOf with size 0 to panic")
+			}
+		}()
+		ChunksOf(0, []int{1})
+	})
+
+	t.Run("seq", func(t *testing.T) {
+		result := Collect(ChunksOfSeq(2, FromSlice([]int{1, 2, 3})))
+		expected := [][]int{{1, 2}, {3}}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+}
+
+func TestSlidingWindows(t *testing.T) {
+	tests := []struct {
+		name       string
+		size, step int
+		input      []int
+		expected   [][]int
+	}{
+		{"overlapping", 3, 1, []int{1, 2, 3, 4, 5}, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}},
+		{"step equals size", 2, 2, []int{1, 2, 3, 4, 5}, [][]int{{1, 2}, {3, 4}}},
+		{"step larger than size", 2, 3, []int{1, 2, 3, 4, 5, 6, 7, 8}, [][]int{{1, 2}, {4, 5}, {7, 8}}},
+		{"input shorter than window", 4, 1, []int{1, 2, 3}, [][]int{}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if result := SlidingWindows(tc.size, tc.step, tc.input); !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, result)
+			}
+			if result := AppendSeq([][]int{}, SlidingWindowsSeq(tc.size, tc.step, FromSlice(tc.input))); !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("Seq: expected %v, got %v", tc.expected, result)
+			}
+		})
+	}
+
+	t.Run("non-positive step panics", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("Expected SlidingWindows with step 0 to panic")
+			}
+		}()
+		SlidingWindows(2, 0, []int{1})
+	})
+}
+
+func TestSplitOn(t *testing.T) {
+	split := func(sep, src string) []string {
+		return Map(func(r []rune) string { return string(r) }, SplitOn([]rune(sep), []rune(src)))
+	}
+	splitSeq := func(sep, src string) []string {
+		return Map(func(r []rune) string { return string(r) }, Collect(SplitOnSeq([]rune(sep), FromSlice([]rune(src)))))
+	}
+
+	tests := []struct {
+		name, sep, input string
+		expected         []string
+	}{
+		{"single separator", ",", "a,b,c", []string{"a", "b", "c"}},
+		{"multi-element separator", "::", "x::y::", []string{"x", "y", ""}},
+		{"leading separator", ",", ",a", []string{"", "a"}},
+		{"non-overlapping matches", "aa", "aaa", []string{"", "a"}},
+		{"no separator", ",", "abc", []string{"abc"}},
+		{"empty input", ",", "", []string{""}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if result := split(tc.sep, tc.input); !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("Expected %q, got %q", tc.expected, result)
+			}
+			if result := splitSeq(tc.sep, tc.input); !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("Seq: expected %q, got %q", tc.expected, result)
+			}
+		})
+	}
+
+	t.Run("appending to the last group does not clobber the backing array", func(t *testing.T) {
+		backing := []int{1, 0, 2, 9}
+		groups := SplitOn([]int{0}, backing[:3])
+		_ = append(groups[len(groups)-1], 7)
+		if !reflect.DeepEqual(backing, []int{1, 0, 2, 9}) {
+			t.Errorf("Expected the backing array to be unchanged, got %v", backing)
+		}
+	})
+}
+
+func TestIntersperse(t *testing.T) {
+	if result := string(Intersperse(',', []rune("abc"))); result != "a,b,c" {
+		t.Errorf("Expected a,b,c, got %v", result)
+	}
+	if result := Intersperse(0, []int{}); !reflect.DeepEqual(result, []int{}) {
+		t.Errorf("Expected [], got %v", result)
+	}
+	if result := Collect(IntersperseSeq(0, FromSlice([]int{1, 2}))); !reflect.DeepEqual(result, []int{1, 0, 2}) {
+		t.Errorf("Expected [1 0 2], got %v", result)
+	}
+}
+
+func TestIntercalate(t *testing.T) {
+	words := [][]rune{[]rune("one"), []rune("two"), []rune("three")}
+	if result := string(Intercalate([]rune(", "), words)); result != "one, two, three" {
+		t.Errorf("Expected 'one, two, three', got %v", result)
+	}
+	if result := string(Collect(IntercalateSeq([]rune("-"), FromSlice(words)))); result != "one-two-three" {
+		t.Errorf("Expected 'one-two-three', got %v", result)
+	}
+	if result := Intercalate([]int{0}, [][]int{}); !reflect.DeepEqual(result, []int{}) {
+		t.Errorf("Expected [], got %v", result)
+	}
+}
+
+func TestGroupBySeq(t *testing.T) {
+	result := Collect(GroupOnSeq(strings.ToLower, FromSlice([]string{"a", "A", "b", "a"})))
+	expected := [][]string{{"a", "A"}, {"b"}, {"a"}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	groups := 0
+	for range GroupBySeq(func(a, b int) bool { return a/10 == b/10 }, naturals()) {
+		groups++
+		if groups == 3 {
+			break
+		}
+	}
+	if groups != 3 {
+		t.Errorf("Expected to stop after 3 groups, got %d", groups)
+	}
+}
@@ -0,0 +1,561 @@
+package functionalgo
+
+import (
+	"hash/maphash"
+	"math/bits"
+	"slices"
+)
+
+// Hasher hashes and compares keys of a HashMap or HashSet. Keys that are
+// Equal must have the same Hash.
+type Hasher[K any] interface {
+	Hash(K) uint64
+	Equal(a, b K) bool
+}
+
+// Hashable is implemented by key types that hash themselves, such as structs
+// holding slices, which cannot be used as Go map keys.
+type Hashable[T any] interface {
+	Hash() uint64
+	Equal(other T) bool
+}
+
+var hashSeed = maphash.MakeSeed()
+
+// defaultHasher uses the Hashable methods when the key has them and hashes
+// the key as a comparable value otherwise, panicking if it is not one.
+type defaultHasher[K any] struct{}
+
+func (defaultHasher[K]) Hash(k K) uint64 {
+	if h, ok := any(k).(Hashable[K]); ok {
+		return h.Hash()
+	}
+	return maphash.Comparable(hashSeed, any(k))
+}
+
+func (defaultHasher[K]) Equal(a, b K) bool {
+	if h, ok := any(a).(Hashable[K]); ok {
+		return h.Equal(b)
+	}
+	return any(a) == any(b)
+}
+
+// HashMap is a persistent hash array mapped trie (HAMT). Insert and Delete
+// return new versions in O(log32 n), copying only the path to the changed
+// entry. The zero value is an empty map using the default hasher, which
+// accepts comparable and Hashable keys; use HashMapWith for other keys.
+type HashMap[K any, V any] struct {
+	root   *hamtNode[K, V]
+	hasher Hasher[K]
+}
+
+// hamtNode maps 5 bits of the hash at its depth to entries, storing only the
+// occupied slots. A collision node instead holds keys whose whole hashes are
+// equal, searched linearly.
+type hamtNode[K any, V any] struct {
+	bitmap    uint32
+	entries   []hamtEntry[K, V]
+	size      int
+	collision bool
+}
+
+// hamtEntry is a key-value pair, or a subtree if child is set.
+type hamtEntry[K any, V any] struct {
+	hash  uint64
+	key   K
+	value V
+	child *hamtNode[K, V]
+}
+
+const hamtBits = 5
+
+func HashMapWith[K any, V any](hasher Hasher[K]) HashMap[K, V] {
+	return HashMap[K, V]{hasher: hasher}
+}
+
+// HashMapFromTuples builds a map from key-value pairs, such as the result of
+// Flatten. Later pairs overwrite earlier pairs with the same key.
+func HashMapFromTuples[K any, V any](src []Tuple[K, V]) HashMap[K, V] {
+	var m HashMap[K, V]
+	for _, t := range src {
+		m = m.Insert(t.fst, t.snd)
+	}
+	return m
+}
+
+func HashMapFromMap[K comparable, V any](src map[K]V) HashMap[K, V] {
+	return HashMapFromTuples(Flatten(src))
+}
+
+func (m HashMap[K, V]) hash() Hasher[K] {
+	if m.hasher == nil {
+		return defaultHasher[K]{}
+	}
+	return m.hasher
+}
+
+func (m HashMap[K, V]) with(root *hamtNode[K, V]) HashMap[K, V] {
+	return HashMap[K, V]{root, m.hasher}
+}
+
+func (m HashMap[K, V]) Len() int {
+	if m.root == nil {
+		return 0
+	}
+	return m.root.size
+}
+
+func (m HashMap[K, V]) IsEmpty() bool {
+	return m.root == nil
+}
+
+func (m HashMap[K, V]) Get(key K) Option[V] {
+	if m.root == nil {
+		return None[V]()
+	}
+	h := m.hash()
+	e, ok := m.root.find(h, h.Hash(key), key, 0)
+	if !ok {
+		return None[V]()
+	}
+	return Some(e.value)
+}
+
+func (m HashMap[K, V]) Contains(key K) bool {
+	return m.Get(key).IsSome()
+}
+
+// Insert returns a copy of m with key mapped to value.
+func (m HashMap[K, V]) Insert(key K, value V) HashMap[K, V] {
+	h := m.hash()
+	e := hamtEntry[K, V]{hash: h.Hash(key), key: key, value: value}
+	if m.root == nil {
+		return m.with(newHamtNode(1<<hamtFrag(e.hash, 0), []hamtEntry[K, V]{e}))
+	}
+	root, _ := m.root.insert(h, e, 0, true)
+	return m.with(root)
+}
+
+// Delete returns a copy of m without key, or m itself if key is absent.
+func (m HashMap[K, V]) Delete(key K) HashMap[K, V] {
+	if m.root == nil {
+		return m
+	}
+	h := m.hash()
+	root, _ := m.root.delete(h, h.Hash(key), key, 0)
+	return m.with(root)
+}
+
+// Filter returns the entries for which fn returns true.
+func (m HashMap[K, V]) Filter(fn func(K, V) bool) HashMap[K, V] {
+	if m.root == nil {
+		return m
+	}
+	return m.with(m.root.filter(func(e hamtEntry[K, V]) bool { return fn(e.key, e.value) }))
+}
+
+// Union returns the entries of both maps, preferring the values of m for
+// keys in both. Subtrees shared by the two maps are reused without being
+// visited. Both maps must use the same hasher.
+func (m HashMap[K, V]) Union(other HashMap[K, V]) HashMap[K, V] {
+	return m.with(unionHamt(m.hash(), m.root, other.root, 0))
+}
+
+// Intersection returns the entries of m whose keys are also in other.
+func (m HashMap[K, V]) Intersection(other HashMap[K, V]) HashMap[K, V] {
+	return m.with(intersectHamt(m.hash(), m.root, other.root, 0))
+}
+
+// Diff returns the entries of m whose keys are not in other.
+func (m HashMap[K, V]) Diff(other HashMap[K, V]) HashMap[K, V] {
+	return m.with(diffHamt(m.hash(), m.root, other.root, 0))
+}
+
+// Seq yields the entries of m in an unspecified order.
+func (m HashMap[K, V]) Seq() Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if m.root != nil {
+			m.root.each(yield)
+		}
+	}
+}
+
+// ToTuples returns the entries of m, in an unspecified order, like Flatten.
+func (m HashMap[K, V]) ToTuples() []Tuple[K, V] {
+	return Collect(FromSeq2(m.Seq()))
+}
+
+func hamtFrag(hash uint64, shift uint) uint32 {
+	return uint32(hash>>shift) & (1<<hamtBits - 1)
+}
+
+func newHamtNode[K any, V any](bitmap uint32, entries []hamtEntry[K, V]) *hamtNode[K, V] {
+	if len(entries) == 0 {
+		return nil
+	}
+	n := &hamtNode[K, V]{bitmap: bitmap, entries: entries}
+	for _, e := range entries {
+		if e.child != nil {
+			n.size += e.child.size
+		} else {
+			n.size++
+		}
+	}
+	return n
+}
+
+func newHamtCollision[K any, V any](entries []hamtEntry[K, V]) *hamtNode[K, V] {
+	return &hamtNode[K, V]{entries: entries, size: len(entries), collision: true}
+}
+
+func (e hamtEntry[K, V]) entryHash() uint64 {
+	if e.child != nil {
+		return e.child.entries[0].hash
+	}
+	return e.hash
+}
+
+// hamtChildEntry wraps a subtree as an entry, inlining subtrees that hold a
+// single key-value pair. It returns false for an empty subtree.
+func hamtChildEntry[K any, V any](n *hamtNode[K, V]) (hamtEntry[K, V], bool) {
+	switch {
+	case n == nil:
+		return hamtEntry[K, V]{}, false
+	case len(n.entries) == 1 && n.entries[0].child == nil:
+		return n.entries[0], true
+	default:
+		return hamtEntry[K, V]{child: n}, true
+	}
+}
+
+func (n *hamtNode[K, V]) entryAt(bit uint32) (hamtEntry[K, V], bool) {
+	if n.bitmap&bit == 0 {
+		return hamtEntry[K, V]{}, false
+	}
+	return n.entries[bits.OnesCount32(n.bitmap&(bit-1))], true
+}
+
+func (n *hamtNode[K, V]) find(h Hasher[K], hash uint64, key K, shift uint) (hamtEntry[K, V], bool) {
+	for ; ; shift += hamtBits {
+		if n.collision {
+			for _, e := range n.entries {
+				if e.hash == hash && h.Equal(e.key, key) {
+					return e, true
+				}
+			}
+			return hamtEntry[K, V]{}, false
+		}
+		e, ok := n.entryAt(1 << hamtFrag(hash, shift))
+		switch {
+		case !ok:
+			return e, false
+		case e.child != nil:
+			n = e.child
+		default:
+			return e, e.hash == hash && h.Equal(e.key, key)
+		}
+	}
+}
+
+// insert adds the key-value pair e, returning the new node and whether the
+// key was absent. An existing key keeps its value unless replace is set.
+func (n *hamtNode[K, V]) insert(h Hasher[K], e hamtEntry[K, V], shift uint, replace bool) (*hamtNode[K, V], bool) {
+	if n.collision {
+		if e.hash != n.entries[0].hash {
+			return mergeHamtEntries(hamtEntry[K, V]{child: n}, e, shift), true
+		}
+		for i, c := range n.entries {
+			if h.Equal(c.key, e.key) {
+				if !replace {
+					return n, false
+				}
+				entries := slices.Clone(n.entries)
+				entries[i] = e
+				return newHamtCollision(entries), false
+			}
+		}
+		return newHamtCollision(append(slices.Clone(n.entries), e)), true
+	}
+
+	bit := uint32(1) << hamtFrag(e.hash, shift)
+	idx := bits.OnesCount32(n.bitmap & (bit - 1))
+	if n.bitmap&bit == 0 {
+		return newHamtNode(n.bitmap|bit, slices.Insert(slices.Clone(n.entries), idx, e)), true
+	}
+
+	cur := n.entries[idx]
+	var next hamtEntry[K, V]
+	added := true
+	switch {
+	case cur.child != nil:
+		child, childAdded := cur.child.insert(h, e, shift+hamtBits, replace)
+		if child == cur.child {
+			return n, false
+		}
+		next, added = hamtEntry[K, V]{child: child}, childAdded
+	case cur.hash == e.hash && h.Equal(cur.key, e.key):
+		if !replace {
+			return n, false
+		}
+		next, added = e, false
+	default:
+		next = hamtEntry[K, V]{child: mergeHamtEntries(cur, e, shift+hamtBits)}
+	}
+	entries := slices.Clone(n.entries)
+	entries[idx] = next
+	return newHamtNode(n.bitmap, entries), added
+}
+
+// mergeHamtEntries builds the node at shift holding two entries with
+// different keys, either of which may be a collision node.
+func mergeHamtEntries[K any, V any](a, b hamtEntry[K, V], shift uint) *hamtNode[K, V] {
+	ha, hb := a.entryHash(), b.entryHash()
+	if ha == hb {
+		return newHamtCollision([]hamtEntry[K, V]{a, b})
+	}
+	fa, fb := hamtFrag(ha, shift), hamtFrag(hb, shift)
+	if fa == fb {
+		child := mergeHamtEntries(a, b, shift+hamtBits)
+		return newHamtNode(1<<fa, []hamtEntry[K, V]{{child: child}})
+	}
+	if fa > fb {
+		a, b = b, a
+	}
+	return newHamtNode(1<<fa|1<<fb, []hamtEntry[K, V]{a, b})
+}
+
+// delete removes key, returning the new node, nil if it became empty, and
+// whether the key was present.
+func (n *hamtNode[K, V]) delete(h Hasher[K], hash uint64, key K, shift uint) (*hamtNode[K, V], bool) {
+	if n.collision {
+		for i, e := range n.entries {
+			if e.hash == hash && h.Equal(e.key, key) {
+				return newHamtCollision(slices.Delete(slices.Clone(n.entries), i, i+1)), true
+			}
+		}
+		return n, false
+	}
+
+	bit := uint32(1) << hamtFrag(hash, shift)
+	cur, ok := n.entryAt(bit)
+	if !ok {
+		return n, false
+	}
+	idx := bits.OnesCount32(n.bitmap & (bit - 1))
+	if cur.child != nil {
+		child, removed := cur.child.delete(h, hash, key, shift+hamtBits)
+		if !removed {
+			return n, false
+		}
+		if e, ok := hamtChildEntry(child); ok {
+			entries := slices.Clone(n.entries)
+			entries[idx] = e
+			return newHamtNode(n.bitmap, entries), true
+		}
+	} else if cur.hash != hash || !h.Equal(cur.key, key) {
+		return n, false
+	}
+	return newHamtNode(n.bitmap&^bit, slices.Delete(slices.Clone(n.entries), idx, idx+1)), true
+}
+
+// filter keeps the key-value pairs satisfying keep, returning n itself if
+// they all do.
+func (n *hamtNode[K, V]) filter(keep func(hamtEntry[K, V]) bool) *hamtNode[K, V] {
+	var bitmap uint32
+	var entries []hamtEntry[K, V]
+	changed := false
+	for i, bit := range n.bits() {
+		e := n.entries[i]
+		if e.child != nil {
+			child := e.child.filter(keep)
+			changed = changed || child != e.child
+			e, ok := hamtChildEntry(child)
+			if !ok {
+				continue
+			}
+			entries = append(entries, e)
+		} else if keep(e) {
+			entries = append(entries, e)
+		} else {
+			changed = true
+			continue
+		}
+		bitmap |= bit
+	}
+	switch {
+	case !changed:
+		return n
+	case n.collision && len(entries) > 0:
+		return newHamtCollision(entries)
+	default:
+		return newHamtNode(bitmap, entries)
+	}
+}
+
+// bits yields the index and bit of every entry of n. Collision nodes yield
+// zero bits.
+func (n *hamtNode[K, V]) bits() Seq2[int, uint32] {
+	return func(yield func(int, uint32) bool) {
+		if n.collision {
+			for i := range n.entries {
+				if !yield(i, 0) {
+					return
+				}
+			}
+			return
+		}
+		i := 0
+		for bm := n.bitmap; bm != 0; bm &= bm - 1 {
+			if !yield(i, bm&-bm) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+func (n *hamtNode[K, V]) each(yield func(K, V) bool) bool {
+	for _, e := range n.entries {
+		if e.child != nil {
+			if !e.child.each(yield) {
+				return false
+			}
+		} else if !yield(e.key, e.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// contains reports whether the entry e, or the subtree below it, holds key.
+func (e hamtEntry[K, V]) contains(h Hasher[K], hash uint64, key K, shift uint) bool {
+	if e.child != nil {
+		_, ok := e.child.find(h, hash, key, shift)
+		return ok
+	}
+	return e.hash == hash && h.Equal(e.key, key)
+}
+
+func unionHamt[K any, V any](h Hasher[K], a, b *hamtNode[K, V], shift uint) *hamtNode[K, V] {
+	switch {
+	case a == b || b == nil:
+		return a
+	case a == nil:
+		return b
+	case b.collision:
+		for _, e := range b.entries {
+			a, _ = a.insert(h, e, shift, false)
+		}
+		return a
+	case a.collision:
+		for _, e := range a.entries {
+			b, _ = b.insert(h, e, shift, true)
+		}
+		return b
+	}
+	bitmap := a.bitmap | b.bitmap
+	entries := make([]hamtEntry[K, V], 0, bits.OnesCount32(bitmap))
+	for bm := bitmap; bm != 0; bm &= bm - 1 {
+		bit := bm & -bm
+		ea, inA := a.entryAt(bit)
+		eb, inB := b.entryAt(bit)
+		switch {
+		case !inB:
+			entries = append(entries, ea)
+		case !inA:
+			entries = append(entries, eb)
+		default:
+			entries = append(entries, unionHamtEntries(h, ea, eb, shift+hamtBits))
+		}
+	}
+	return newHamtNode(bitmap, entries)
+}
+
+func unionHamtEntries[K any, V any](h Hasher[K], a, b hamtEntry[K, V], shift uint) hamtEntry[K, V] {
+	switch {
+	case a.child != nil && b.child != nil:
+		return hamtEntry[K, V]{child: unionHamt(h, a.child, b.child, shift)}
+	case a.child != nil:
+		child, _ := a.child.insert(h, b, shift, false)
+		return hamtEntry[K, V]{child: child}
+	case b.child != nil:
+		child, _ := b.child.insert(h, a, shift, true)
+		return hamtEntry[K, V]{child: child}
+	case a.hash == b.hash && h.Equal(a.key, b.key):
+		return a
+	default:
+		return hamtEntry[K, V]{child: mergeHamtEntries(a, b, shift)}
+	}
+}
+
+func intersectHamt[K any, V any](h Hasher[K], a, b *hamtNode[K, V], shift uint) *hamtNode[K, V] {
+	switch {
+	case a == b:
+		return a
+	case a == nil || b == nil:
+		return nil
+	case a.collision || b.collision:
+		return a.filter(func(e hamtEntry[K, V]) bool {
+			_, ok := b.find(h, e.hash, e.key, shift)
+			return ok
+		})
+	}
+	var bitmap uint32
+	var entries []hamtEntry[K, V]
+	for bm := a.bitmap & b.bitmap; bm != 0; bm &= bm - 1 {
+		bit := bm & -bm
+		ea, _ := a.entryAt(bit)
+		eb, _ := b.entryAt(bit)
+		var ok bool
+		switch {
+		case ea.child != nil && eb.child != nil:
+			ea, ok = hamtChildEntry(intersectHamt(h, ea.child, eb.child, shift+hamtBits))
+		case ea.child != nil:
+			ea, ok = ea.child.find(h, eb.hash, eb.key, shift+hamtBits)
+		default:
+			ok = eb.contains(h, ea.hash, ea.key, shift+hamtBits)
+		}
+		if ok {
+			bitmap |= bit
+			entries = append(entries, ea)
+		}
+	}
+	return newHamtNode(bitmap, entries)
+}
+
+func diffHamt[K any, V any](h Hasher[K], a, b *hamtNode[K, V], shift uint) *hamtNode[K, V] {
+	switch {
+	case a == b || a == nil:
+		return nil
+	case b == nil:
+		return a
+	case a.collision || b.collision:
+		return a.filter(func(e hamtEntry[K, V]) bool {
+			_, ok := b.find(h, e.hash, e.key, shift)
+			return !ok
+		})
+	}
+	var bitmap uint32
+	var entries []hamtEntry[K, V]
+	for bm := a.bitmap; bm != 0; bm &= bm - 1 {
+		bit := bm & -bm
+		ea, _ := a.entryAt(bit)
+		eb, inB := b.entryAt(bit)
+		ok := true
+		switch {
+		case !inB:
+		case ea.child != nil && eb.child != nil:
+			ea, ok = hamtChildEntry(diffHamt(h, ea.child, eb.child, shift+hamtBits))
+		case ea.child != nil:
+			child, _ := ea.child.delete(h, eb.hash, eb.key, shift+hamtBits)
+			ea, ok = hamtChildEntry(child)
+		default:
+			ok = !eb.contains(h, ea.hash, ea.key, shift+hamtBits)
+		}
+		if ok {
+			bitmap |= bit
+			entries = append(entries, ea)
+		}
+	}
+	return newHamtNode(bitmap, entries)
+}
@@ -0,0 +1,190 @@
+package functionalgo
+
+import (
+	"hash/maphash"
+	"maps"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// collidingHasher maps every int to one of four hashes so that tests
+// exercise collision nodes.
+type collidingHasher struct{}
+
+func (collidingHasher) Hash(k int) uint64   { return uint64(k % 4) }
+func (collidingHasher) Equal(a, b int) bool { return a == b }
+
+type filePath struct {
+	segments []string
+}
+
+func (p filePath) Hash() uint64 {
+	var h maphash.Hash
+	h.SetSeed(hashSeed)
+	for _, s := range p.segments {
+		h.WriteString(s)
+		h.WriteByte('/')
+	}
+	return h.Sum64()
+}
+
+func (p filePath) Equal(other filePath) bool {
+	return slices.Equal(p.segments, other.segments)
+}
+
+// checkHashMap verifies m against a model map.
+func checkHashMap(t *testing.T, m HashMap[int, int], model map[int]int) {
+	t.Helper()
+	if m.Len() != len(model) {
+		t.Fatalf("Expected %d entries, got %d", len(model), m.Len())
+	}
+	for k, v := range model {
+		if got, ok := m.Get(k).Get(); !ok || got != v {
+			t.Fatalf("Expected %d -> %d, got %v", k, v, m.Get(k))
+		}
+	}
+	seen := map[int]int{}
+	for k, v := range m.Seq() {
+		seen[k] = v
+	}
+	if !reflect.DeepEqual(seen, model) {
+		t.Fatalf("Expected iteration to yield %v, got %v", model, seen)
+	}
+}
+
+func TestHashMapBasics(t *testing.T) {
+	var empty HashMap[string, int]
+	if empty.Len() != 0 || !empty.IsEmpty() || empty.Get("a").IsSome() {
+		t.Errorf("Expected the zero value to be an empty map")
+	}
+
+	m := empty.Insert("a", 1).Insert("b", 2)
+	m2 := m.Insert("a", 10).Delete("b")
+	if v, _ := m.Get("a").Get(); v != 1 || m.Len() != 2 {
+		t.Errorf("Expected the original to be unchanged, got a=%v len=%d", v, m.Len())
+	}
+	if v, _ := m2.Get("a").Get(); v != 10 || m2.Contains("b") || m2.Len() != 1 {
+		t.Errorf("Expected a=10 without b, got a=%v len=%d", v, m2.Len())
+	}
+	if m.Delete("zzz").root != m.root {
+		t.Errorf("Expected deleting a missing key to return the same map")
+	}
+}
+
+func TestHashMapTuples(t *testing.T) {
+	src := map[string]int{"apple": 5, "banana": 3, "cherry": 7}
+	m := HashMapFromMap(src)
+	result := SortBy(Comparing(func(t Tuple[string, int]) string { return Fst(t) }), m.ToTuples())
+	if !reflect.DeepEqual(result, FlattenSorted(src)) {
+		t.Errorf("Expected %v, got %v", FlattenSorted(src), result)
+	}
+	last := HashMapFromTuples([]Tuple[string, int]{MkTuple("a", 1), MkTuple("a", 2)})
+	if v, _ := last.Get("a").Get(); v != 2 || last.Len() != 1 {
+		t.Errorf("Expected later tuples to win, got %v", v)
+	}
+}
+
+func TestHashMapHashableKeys(t *testing.T) {
+	var m HashMap[filePath, string]
+	m = m.Insert(filePath{[]string{"usr", "bin"}}, "binaries")
+	m = m.Insert(filePath{[]string{"usr", "lib"}}, "libraries")
+	if v, ok := m.Get(filePath{[]string{"usr", "bin"}}).Get(); !ok || v != "binaries" {
+		t.Errorf("Expected binaries, got %v", v)
+	}
+	if m.Contains(filePath{[]string{"usr"}}) {
+		t.Errorf("Expected /usr to be absent")
+	}
+
+	t.Run("non-comparable key without hasher panics", func(t *testing.T) {
+		expectPanic(t, "Insert", func() { HashMap[any, int]{}.Insert([]int{1}, 1) })
+	})
+}
+
+func TestHashMapRandomOps(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		empty HashMap[int, int]
+	}{
+		{"default hasher", HashMap[int, int]{}},
+		{"colliding hasher", HashMapWith[int, int](collidingHasher{})},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rng := rand.New(rand.NewPCG(3, 4))
+			m, model := tc.empty, map[int]int{}
+			for step := range 3000 {
+				k := rng.IntN(500)
+				if rng.IntN(3) == 0 {
+					m = m.Delete(k)
+					delete(model, k)
+				} else {
+					m = m.Insert(k, step)
+					model[k] = step
+				}
+				if step%500 == 0 {
+					checkHashMap(t, m, model)
+				}
+			}
+			checkHashMap(t, m, model)
+		})
+	}
+}
+
+func TestHashMapSetOperations(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		empty HashMap[int, int]
+	}{
+		{"default hasher", HashMap[int, int]{}},
+		{"colliding hasher", HashMapWith[int, int](collidingHasher{})},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rng := rand.New(rand.NewPCG(5, 6))
+			base, baseModel := tc.empty, map[int]int{}
+			for i := range 2000 {
+				base, baseModel[i] = base.Insert(i, i), i
+			}
+			a, b := base, base
+			aModel, bModel := maps.Clone(baseModel), maps.Clone(baseModel)
+			for range 300 {
+				k := rng.IntN(2500)
+				a, aModel[k] = a.Insert(k, -k), -k
+				k = rng.IntN(2500)
+				b = b.Delete(k)
+				delete(bModel, k)
+			}
+
+			union := map[int]int{}
+			for k, v := range bModel {
+				union[k] = v
+			}
+			for k, v := range aModel {
+				union[k] = v
+			}
+			checkHashMap(t, a.Union(b), union)
+
+			intersection, diff := map[int]int{}, map[int]int{}
+			for k, v := range aModel {
+				if _, ok := bModel[k]; ok {
+					intersection[k] = v
+				} else {
+					diff[k] = v
+				}
+			}
+			checkHashMap(t, a.Intersection(b), intersection)
+			checkHashMap(t, a.Diff(b), diff)
+
+			if a.Union(a).root != a.root || a.Intersection(a).root != a.root || !a.Diff(a).IsEmpty() {
+				t.Errorf("Expected operations on identical maps to short-circuit")
+			}
+			evens := map[int]int{}
+			for k, v := range aModel {
+				if k%2 == 0 {
+					evens[k] = v
+				}
+			}
+			checkHashMap(t, a.Filter(func(k, _ int) bool { return k%2 == 0 }), evens)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package functionalgo
+
+// HashSet is a persistent set backed by a HashMap with empty values. The
+// zero value is an empty set using the default hasher.
+type HashSet[T any] struct {
+	m HashMap[T, struct{}]
+}
+
+func HashSetWith[T any](hasher Hasher[T]) HashSet[T] {
+	return HashSet[T]{HashMapWith[T, struct{}](hasher)}
+}
+
+func HashSetOf[T any](xs ...T) HashSet[T] {
+	return HashSetFromSlice(xs)
+}
+
+func HashSetFromSlice[T any](src []T) HashSet[T] {
+	var s HashSet[T]
+	for _, x := range src {
+		s = s.Insert(x)
+	}
+	return s
+}
+
+func (s HashSet[T]) Len() int {
+	return s.m.Len()
+}
+
+func (s HashSet[T]) IsEmpty() bool {
+	return s.m.IsEmpty()
+}
+
+func (s HashSet[T]) Contains(x T) bool {
+	return s.m.Contains(x)
+}
+
+func (s HashSet[T]) Insert(x T) HashSet[T] {
+	if s.m.Contains(x) {
+		return s
+	}
+	return HashSet[T]{s.m.Insert(x, struct{}{})}
+}
+
+func (s HashSet[T]) Delete(x T) HashSet[T] {
+	return HashSet[T]{s.m.Delete(x)}
+}
+
+func (s HashSet[T]) Filter(fn func(T) bool) HashSet[T] {
+	return HashSet[T]{s.m.Filter(func(x T, _ struct{}) bool { return fn(x) })}
+}
+
+// Union, Intersection and Diff reuse subtrees shared by the two sets. Both
+// sets must use the same hasher.
+func (s HashSet[T]) Union(other HashSet[T]) HashSet[T] {
+	return HashSet[T]{s.m.Union(other.m)}
+}
+
+func (s HashSet[T]) Intersection(other HashSet[T]) HashSet[T] {
+	return HashSet[T]{s.m.Intersection(other.m)}
+}
+
+func (s HashSet[T]) Diff(other HashSet[T]) HashSet[T] {
+	return HashSet[T]{s.m.Diff(other.m)}
+}
+
+// Seq yields the elements of s in an unspecified order.
+func (s HashSet[T]) Seq() Seq[T] {
+	return keysOf(s.m.Seq())
+}
+
+func (s HashSet[T]) ToSlice() []T {
+	return Collect(s.Seq())
+}
@@ -0,0 +1,45 @@
+package functionalgo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHashSet(t *testing.T) {
+	s := HashSetOf(3, 1, 3, 2)
+	if s.Len() != 3 || !s.Contains(2) || s.Contains(4) {
+		t.Errorf("Expected {1, 2, 3}, got %v", s.ToSlice())
+	}
+	if s.Insert(1).m.root != s.m.root {
+		t.Errorf("Expected inserting an existing element to return the same set")
+	}
+	if d := s.Delete(3); d.Len() != 2 || d.Contains(3) || !s.Contains(3) {
+		t.Errorf("Expected Delete to return a new set without 3")
+	}
+
+	other := HashSetOf(2, 3, 4)
+	tests := []struct {
+		name     string
+		result   HashSet[int]
+		expected []int
+	}{
+		{"union", s.Union(other), []int{1, 2, 3, 4}},
+		{"intersection", s.Intersection(other), []int{2, 3}},
+		{"diff", s.Diff(other), []int{1}},
+		{"filter", s.Filter(func(x int) bool { return x > 1 }), []int{2, 3}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if result := Sort(tc.result.ToSlice()); !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, result)
+			}
+		})
+	}
+
+	t.Run("custom hasher", func(t *testing.T) {
+		s := HashSetWith[int](collidingHasher{}).Insert(1).Insert(5).Insert(9)
+		if s.Len() != 3 || !s.Contains(5) || s.Delete(5).Contains(5) {
+			t.Errorf("Expected colliding elements to be kept apart")
+		}
+	})
+}
@@ -0,0 +1,200 @@
+package functionalgo
+
+// Heap is a persistent priority queue stored as a leftist heap. FindMin is
+// O(1); Insert, DeleteMin and Merge are O(log n) and share all but one path
+// with their inputs. The zero value is an empty heap ordered by Compare; use
+// HeapBy for another ordering, such as a Reversed one for a max-heap.
+type Heap[T any] struct {
+	root *heapNode[T]
+	ord  Ordering[T]
+}
+
+// heapNode's rank is the length of its right spine, which a leftist heap
+// keeps no longer than the left one.
+type heapNode[T any] struct {
+	value       T
+	left, right *heapNode[T]
+	rank, size  int
+}
+
+func HeapBy[T any](ord Ordering[T]) Heap[T] {
+	return Heap[T]{ord: ord}
+}
+
+func HeapOf[T any](xs ...T) Heap[T] {
+	return HeapFromSlice(xs)
+}
+
+// HeapFromSlice builds a heap ordered by Compare in O(n) by merging
+// singleton heaps pairwise.
+func HeapFromSlice[T any](src []T) Heap[T] {
+	if len(src) == 0 {
+		return Heap[T]{}
+	}
+	nodes := make([]*heapNode[T], len(src))
+	for i, x := range src {
+		nodes[i] = &heapNode[T]{value: x, rank: 1, size: 1}
+	}
+	for len(nodes) > 1 {
+		merged := nodes[:0]
+		for i := 0; i+1 < len(nodes); i += 2 {
+			merged = append(merged, mergeHeap(Compare[T], nodes[i], nodes[i+1]))
+		}
+		if len(nodes)%2 == 1 {
+			merged = append(merged, nodes[len(nodes)-1])
+		}
+		nodes = merged
+	}
+	return Heap[T]{root: nodes[0]}
+}
+
+func (h Heap[T]) compare() Ordering[T] {
+	if h.ord == nil {
+		return Compare[T]
+	}
+	return h.ord
+}
+
+func (h Heap[T]) Len() int {
+	return h.root.len()
+}
+
+func (h Heap[T]) IsEmpty() bool {
+	return h.root == nil
+}
+
+func (h Heap[T]) Insert(x T) Heap[T] {
+	return Heap[T]{mergeHeap(h.compare(), h.root, &heapNode[T]{value: x, rank: 1, size: 1}), h.ord}
+}
+
+// FindMin returns the least element, or None if h is empty.
+func (h Heap[T]) FindMin() Option[T] {
+	if h.root == nil {
+		return None[T]()
+	}
+	return Some(h.root.value)
+}
+
+// DeleteMin returns h without its least element; an empty heap is returned
+// unchanged.
+func (h Heap[T]) DeleteMin() Heap[T] {
+	if h.root == nil {
+		return h
+	}
+	return Heap[T]{mergeHeap(h.compare(), h.root.left, h.root.right), h.ord}
+}
+
+// PopMin is FindMin and DeleteMin together.
+func (h Heap[T]) PopMin() (Option[T], Heap[T]) {
+	return h.FindMin(), h.DeleteMin()
+}
+
+// Merge combines the elements of h and other, using h's ordering.
+func (h Heap[T]) Merge(other Heap[T]) Heap[T] {
+	return Heap[T]{mergeHeap(h.compare(), h.root, other.root), h.ord}
+}
+
+// Seq yields the elements in ascending order, doing O(log n) work for each
+// element taken.
+func (h Heap[T]) Seq() Seq[T] {
+	return func(yield func(T) bool) {
+		for ; h.root != nil; h = h.DeleteMin() {
+			if !yield(h.root.value) {
+				return
+			}
+		}
+	}
+}
+
+func (h Heap[T]) ToSlice() []T {
+	return AppendSeq(make([]T, 0, h.Len()), h.Seq())
+}
+
+func (n *heapNode[T]) len() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func (n *heapNode[T]) rankOf() int {
+	if n == nil {
+		return 0
+	}
+	return n.rank
+}
+
+// mergeHeap walks down the right spines of a and b, which are O(log n)
+// long, and swaps children wherever needed to keep the heap leftist.
+func mergeHeap[T any](ord Ordering[T], a, b *heapNode[T]) *heapNode[T] {
+	switch {
+	case a == nil:
+		return b
+	case b == nil:
+		return a
+	case ord(b.value, a.value) == LT:
+		a, b = b, a
+	}
+	left, right := a.left, mergeHeap(ord, a.right, b)
+	if left.rankOf() < right.rankOf() {
+		left, right = right, left
+	}
+	return &heapNode[T]{a.value, left, right, right.rankOf() + 1, a.size + b.size}
+}
+
+func MapHeap[A any, B any](fn func(A) B, h Heap[A]) Heap[B] {
+	return HeapFromSlice(Map(fn, h.ToSlice()))
+}
+
+func FoldlHeap[A any, B any](fn func(B, A) B, acc B, h Heap[A]) B {
+	return FoldlSeq(fn, acc, h.Seq())
+}
+
+// TopK returns the k greatest elements of src, greatest first. Equal
+// elements keep their order in src.
+func TopK[A any](k int, src []A) []A {
+	return TopKBy(Compare[A], k, src)
+}
+
+func TopKBy[A any](ord Ordering[A], k int, src []A) []A {
+	return selectK(ord.Reversed(), k, src)
+}
+
+// BottomK returns the k least elements of src, least first. Equal elements
+// keep their order in src.
+func BottomK[A any](k int, src []A) []A {
+	return BottomKBy(Compare[A], k, src)
+}
+
+func BottomKBy[A any](ord Ordering[A], k int, src []A) []A {
+	return selectK(ord, k, src)
+}
+
+// selectK returns the first k elements of src under ord in O(n log k),
+// keeping the candidates in a heap whose root is the one to evict next.
+func selectK[A any](ord Ordering[A], k int, src []A) []A {
+	type indexed struct {
+		i int
+		x A
+	}
+	worst := HeapBy(func(a, b indexed) ComparisonResult {
+		if c := ord(b.x, a.x); c != EQ {
+			return c
+		}
+		return CompareOrd(b.i, a.i)
+	})
+	for i, x := range src {
+		switch {
+		case worst.Len() < k:
+			worst = worst.Insert(indexed{i, x})
+		case k > 0 && ord(x, worst.root.value.x) == LT:
+			worst = worst.DeleteMin().Insert(indexed{i, x})
+		}
+	}
+	result := make([]A, worst.Len())
+	for i := len(result) - 1; i >= 0; i-- {
+		result[i] = worst.root.value.x
+		worst = worst.DeleteMin()
+	}
+	return result
+}
@@ -0,0 +1,158 @@
+package functionalgo
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func checkHeap[T any](t *testing.T, h Heap[T]) {
+	t.Helper()
+	ord := h.compare()
+	var check func(n *heapNode[T]) int
+	check = func(n *heapNode[T]) int {
+		if n == nil {
+			return 0
+		}
+		for _, c := range []*heapNode[T]{n.left, n.right} {
+			if c != nil && ord(c.value, n.value) == LT {
+				t.Fatalf("Expected %v not to be below its child %v", n.value, c.value)
+			}
+		}
+		if n.left.rankOf() < n.right.rankOf() || n.rank != n.right.rankOf()+1 {
+			t.Fatalf("Expected a leftist node with correct rank")
+		}
+		if size := check(n.left) + check(n.right) + 1; size != n.size {
+			t.Fatalf("Expected size %d, got %d", size, n.size)
+		}
+		return n.size
+	}
+	check(h.root)
+}
+
+func TestHeapBasics(t *testing.T) {
+	var empty Heap[int]
+	if !empty.IsEmpty() || empty.FindMin().IsSome() || !empty.DeleteMin().IsEmpty() {
+		t.Errorf("Expected the zero value to be an empty heap")
+	}
+
+	h := HeapOf(5, 1, 4, 1, 3)
+	checkHeap(t, h)
+	if h.FindMin() != Some(1) || h.Len() != 5 {
+		t.Errorf("Expected minimum 1 and length 5, got %v and %d", h.FindMin(), h.Len())
+	}
+	if result := h.ToSlice(); !reflect.DeepEqual(result, []int{1, 1, 3, 4, 5}) {
+		t.Errorf("Expected [1 1 3 4 5], got %v", result)
+	}
+	x, rest := h.Insert(0).PopMin()
+	if x != Some(0) || rest.Len() != 5 {
+		t.Errorf("Expected Some(0) and five remaining, got %v and %d", x, rest.Len())
+	}
+	if h.Len() != 5 {
+		t.Errorf("Expected the original heap to be unchanged")
+	}
+
+	t.Run("max heap", func(t *testing.T) {
+		maxHeap := HeapBy(Ordering[int](Compare[int]).Reversed()).Insert(2).Insert(7).Insert(3)
+		if result := maxHeap.ToSlice(); !reflect.DeepEqual(result, []int{7, 3, 2}) {
+			t.Errorf("Expected [7 3 2], got %v", result)
+		}
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		merged := HeapOf(4, 8).Merge(HeapOf(1, 9, 2))
+		checkHeap(t, merged)
+		if result := merged.ToSlice(); !reflect.DeepEqual(result, []int{1, 2, 4, 8, 9}) {
+			t.Errorf("Expected [1 2 4 8 9], got %v", result)
+		}
+	})
+
+	t.Run("combinators", func(t *testing.T) {
+		if result := MapHeap(func(x int) int { return -x }, h).ToSlice(); !reflect.DeepEqual(result, []int{-5, -4, -3, -1, -1}) {
+			t.Errorf("Expected [-5 -4 -3 -1 -1], got %v", result)
+		}
+		if result := FoldlHeap(func(acc []int, x int) []int { return append(acc, x) }, nil, h); !reflect.DeepEqual(result, []int{1, 1, 3, 4, 5}) {
+			t.Errorf("Expected ascending order, got %v", result)
+		}
+		if result := Collect(TakeSeq(h.Seq(), 2)); !reflect.DeepEqual(result, []int{1, 1}) {
+			t.Errorf("Expected [1 1], got %v", result)
+		}
+	})
+}
+
+func TestHeapRandomOps(t *testing.T) {
+	rng := rand.New(rand.NewPCG(11, 12))
+	var h Heap[int]
+	var model []int
+	for step := range 2000 {
+		switch op := rng.IntN(5); {
+		case op < 3:
+			x := rng.IntN(100)
+			h, model = h.Insert(x), append(model, x)
+		case op < 4 && len(model) > 0:
+			slices.Sort(model)
+			if h.FindMin() != Some(model[0]) {
+				t.Fatalf("Step %d: expected Some(%d), got %v", step, model[0], h.FindMin())
+			}
+			h, model = h.DeleteMin(), model[1:]
+		default:
+			src := Range(0, rng.IntN(20), 1)
+			h, model = h.Merge(HeapFromSlice(src)), append(model, src...)
+		}
+		if step%100 == 0 {
+			checkHeap(t, h)
+		}
+	}
+	slices.Sort(model)
+	if !slices.Equal(h.ToSlice(), model) {
+		t.Errorf("Expected the heap to match the sorted model")
+	}
+}
+
+func TestTopKBottomK(t *testing.T) {
+	src := []int{5, 1, 9, 3, 9, 7, 1}
+	for _, tc := range []struct {
+		name     string
+		result   []int
+		expected []int
+	}{
+		{"top 3", TopK(3, src), []int{9, 9, 7}},
+		{"bottom 3", BottomK(3, src), []int{1, 1, 3}},
+		{"k larger than input", TopK(10, src), []int{9, 9, 7, 5, 3, 1, 1}},
+		{"k zero", BottomK(0, src), []int{}},
+		{"empty input", TopK(2, []int{}), []int{}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tc.result, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, tc.result)
+			}
+		})
+	}
+
+	t.Run("ties keep source order", func(t *testing.T) {
+		words := []string{"bb", "a", "cc", "dd", "e"}
+		byLen := Comparing(func(s string) int { return len(s) })
+		if result := TopKBy(byLen, 2, words); !reflect.DeepEqual(result, []string{"bb", "cc"}) {
+			t.Errorf("Expected [bb cc], got %v", result)
+		}
+		if result := BottomKBy(byLen, 3, words); !reflect.DeepEqual(result, []string{"a", "e", "bb"}) {
+			t.Errorf("Expected [a e bb], got %v", result)
+		}
+	})
+
+	t.Run("agrees with sorting", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(13, 14))
+		xs := make([]int, 500)
+		for i := range xs {
+			xs[i] = rng.IntN(50)
+		}
+		sorted := Sort(xs)
+		if result := BottomK(40, xs); !slices.Equal(result, sorted[:40]) {
+			t.Errorf("Expected the 40 least elements in order, got %v", result)
+		}
+		if result := TopK(40, xs); !slices.Equal(result, Reverse(sorted)[:40]) {
+			t.Errorf("Expected the 40 greatest elements in order, got %v", result)
+		}
+	})
+}
@@ -0,0 +1,214 @@
+package functionalgo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// List is a persistent singly linked list. Cons, Head and Tail are O(1) and
+// every operation returns a new list that shares as many nodes as possible
+// with its inputs, so no version is ever modified. The zero value is the
+// empty list.
+type List[T any] struct {
+	node *listNode[T]
+}
+
+type listNode[T any] struct {
+	head T
+	tail *listNode[T]
+	size int
+}
+
+func Cons[T any](x T, l List[T]) List[T] {
+	return List[T]{&listNode[T]{x, l.node, l.Len() + 1}}
+}
+
+func ListOf[T any](xs ...T) List[T] {
+	return ListFromSlice(xs)
+}
+
+func ListFromSlice[T any](src []T) List[T] {
+	return prependSlice(src, List[T]{})
+}
+
+// ListFromSeq builds a list from a finite sequence.
+func ListFromSeq[T any](seq Seq[T]) List[T] {
+	return ListFromSlice(Collect(seq))
+}
+
+func (l List[T]) IsEmpty() bool {
+	return l.node == nil
+}
+
+// Len is O(1).
+func (l List[T]) Len() int {
+	if l.node == nil {
+		return 0
+	}
+	return l.node.size
+}
+
+func (l List[T]) Head() T {
+	if l.node == nil {
+		panic("cannot take head of empty list")
+	}
+	return l.node.head
+}
+
+func (l List[T]) Tail() List[T] {
+	if l.node == nil {
+		panic("cannot take tail of empty list")
+	}
+	return List[T]{l.node.tail}
+}
+
+// Uncons returns the head and tail of l, or None if l is empty.
+func (l List[T]) Uncons() Option[Tuple[T, List[T]]] {
+	if l.node == nil {
+		return None[Tuple[T, List[T]]]()
+	}
+	return Some(MkTuple(l.node.head, List[T]{l.node.tail}))
+}
+
+func (l List[T]) Seq() Seq[T] {
+	return func(yield func(T) bool) {
+		for n := l.node; n != nil; n = n.tail {
+			if !yield(n.head) {
+				return
+			}
+		}
+	}
+}
+
+func (l List[T]) ToSlice() []T {
+	result := make([]T, 0, l.Len())
+	for n := l.node; n != nil; n = n.tail {
+		result = append(result, n.head)
+	}
+	return result
+}
+
+func (l List[T]) String() string {
+	var sb strings.Builder
+	sb.WriteByte('[')
+	for n := l.node; n != nil; n = n.tail {
+		if n != l.node {
+			sb.WriteString(", ")
+		}
+		fmt.Fprint(&sb, n.head)
+	}
+	sb.WriteByte(']')
+	return sb.String()
+}
+
+// CompareTo compares lists lexicographically, a shorter prefix sorting first.
+func (l List[T]) CompareTo(other List[T]) ComparisonResult {
+	a, b := l.node, other.node
+	for ; a != nil && b != nil; a, b = a.tail, b.tail {
+		if a == b {
+			return EQ
+		}
+		if c := Compare(a.head, b.head); c != EQ {
+			return c
+		}
+	}
+	switch {
+	case a == b:
+		return EQ
+	case a == nil:
+		return LT
+	default:
+		return GT
+	}
+}
+
+// prependSlice conses the elements of src onto l, keeping their order.
+func prependSlice[T any](src []T, l List[T]) List[T] {
+	for i := len(src) - 1; i >= 0; i-- {
+		l = Cons(src[i], l)
+	}
+	return l
+}
+
+func MapList[A any, B any](fn func(A) B, l List[A]) List[B] {
+	return ListFromSlice(Map(fn, l.ToSlice()))
+}
+
+// FilterList shares the longest suffix of l whose elements all satisfy fn.
+func FilterList[A any](fn func(A) bool, l List[A]) List[A] {
+	kept := []A{}
+	shared := l.node
+	for n := l.node; n != nil; n = n.tail {
+		if fn(n.head) {
+			continue
+		}
+		for ; shared != n; shared = shared.tail {
+			kept = append(kept, shared.head)
+		}
+		shared = n.tail
+	}
+	return prependSlice(kept, List[A]{shared})
+}
+
+func FoldlList[A any, B any](fn func(B, A) B, acc B, l List[A]) B {
+	for n := l.node; n != nil; n = n.tail {
+		acc = fn(acc, n.head)
+	}
+	return acc
+}
+
+func FoldrList[A any, B any](fn func(A, B) B, acc B, l List[A]) B {
+	return Foldr(fn, acc, l.ToSlice())
+}
+
+func ZipWithList[A any, B any, C any](fn func(A, B) C, la List[A], lb List[B]) List[C] {
+	return ListFromSeq(ZipWithSeq(fn, la.Seq(), lb.Seq()))
+}
+
+func ZipList[A any, B any](la List[A], lb List[B]) List[Tuple[A, B]] {
+	return ZipWithList(MkTuple[A, B], la, lb)
+}
+
+func AnyList[A any](fn func(A) bool, l List[A]) bool {
+	for n := l.node; n != nil; n = n.tail {
+		if fn(n.head) {
+			return true
+		}
+	}
+	return false
+}
+
+func AllList[A any](fn func(A) bool, l List[A]) bool {
+	return !AnyList(func(x A) bool { return !fn(x) }, l)
+}
+
+// TakeList copies the first num nodes of l; TakeList(l, l.Len()) returns l
+// itself.
+func TakeList[A any](l List[A], num int) List[A] {
+	if num >= l.Len() {
+		return l
+	}
+	return ListFromSeq(TakeSeq(l.Seq(), num))
+}
+
+// DropList returns the list after the first num elements, sharing its nodes
+// with l.
+func DropList[A any](l List[A], num int) List[A] {
+	n := l.node
+	for ; n != nil && num > 0; num-- {
+		n = n.tail
+	}
+	return List[A]{n}
+}
+
+// AppendList copies the nodes of a and shares b as the tail.
+func AppendList[A any](a, b List[A]) List[A] {
+	if b.IsEmpty() {
+		return a
+	}
+	return prependSlice(a.ToSlice(), b)
+}
+
+func ReverseList[A any](l List[A]) List[A] {
+	return FoldlList(func(acc List[A], x A) List[A] { return Cons(x, acc) }, List[A]{}, l)
+}
@@ -0,0 +1,133 @@
+package functionalgo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestListBasics(t *testing.T) {
+	var empty List[int]
+	if !empty.IsEmpty() || empty.Len() != 0 || empty.String() != "[]" {
+		t.Errorf("Expected the zero value to be an empty list")
+	}
+
+	l := Cons(1, ListOf(2, 3))
+	if l.Head() != 1 || l.Len() != 3 {
+		t.Errorf("Expected head 1 and length 3, got %v and %d", l.Head(), l.Len())
+	}
+	if result := l.Tail().ToSlice(); !reflect.DeepEqual(result, []int{2, 3}) {
+		t.Errorf("Expected [2 3], got %v", result)
+	}
+	if l.String() != "[1, 2, 3]" {
+		t.Errorf("Expected [1, 2, 3], got %s", l.String())
+	}
+	if result := Collect(l.Seq()); !reflect.DeepEqual(result, []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", result)
+	}
+
+	t.Run("uncons", func(t *testing.T) {
+		pair, ok := l.Uncons().Get()
+		if !ok || Fst(pair) != 1 || Snd(pair).Len() != 2 {
+			t.Errorf("Expected Some((1, [2, 3])), got %v", pair)
+		}
+		if empty.Uncons().IsSome() {
+			t.Errorf("Expected None for the empty list")
+		}
+	})
+
+	t.Run("head and tail of empty list panic", func(t *testing.T) {
+		expectPanic(t, "Head", func() { empty.Head() })
+		expectPanic(t, "Tail", func() { empty.Tail() })
+	})
+}
+
+func TestListPersistence(t *testing.T) {
+	base := ListOf(2, 3)
+	a := Cons(1, base)
+	b := Cons(9, base)
+	if a.Tail().node != base.node || b.Tail().node != base.node {
+		t.Errorf("Expected both versions to share the tail")
+	}
+	if !reflect.DeepEqual(a.ToSlice(), []int{1, 2, 3}) || !reflect.DeepEqual(b.ToSlice(), []int{9, 2, 3}) {
+		t.Errorf("Expected versions to be independent, got %v %v", a, b)
+	}
+	if DropList(a, 1).node != base.node {
+		t.Errorf("Expected DropList to share nodes")
+	}
+	if AppendList(ListOf(0), a).Tail().node != a.node {
+		t.Errorf("Expected AppendList to share its second argument")
+	}
+}
+
+func TestListCombinators(t *testing.T) {
+	l := ListOf(1, 2, 3, 4)
+	if result := MapList(func(x int) string { return string(rune('a' + x - 1)) }, l).ToSlice(); !reflect.DeepEqual(result, []string{"a", "b", "c", "d"}) {
+		t.Errorf("MapList: got %v", result)
+	}
+	if result := FilterList(func(x int) bool { return x%2 == 0 }, l).ToSlice(); !reflect.DeepEqual(result, []int{2, 4}) {
+		t.Errorf("FilterList: got %v", result)
+	}
+	if result := FoldlList(func(acc, x int) int { return acc - x }, 0, l); result != -10 {
+		t.Errorf("FoldlList: expected -10, got %d", result)
+	}
+	if result := FoldrList(func(x, acc int) int { return x - acc }, 0, l); result != -2 {
+		t.Errorf("FoldrList: expected -2, got %d", result)
+	}
+	if result := ZipList(l, ListOf("x", "y")).ToSlice(); !reflect.DeepEqual(result, []Tuple[int, string]{MkTuple(1, "x"), MkTuple(2, "y")}) {
+		t.Errorf("ZipList: got %v", result)
+	}
+	if !AnyList(func(x int) bool { return x > 3 }, l) || AllList(func(x int) bool { return x > 1 }, l) {
+		t.Errorf("AnyList/AllList: unexpected result")
+	}
+	if result := TakeList(l, 2).ToSlice(); !reflect.DeepEqual(result, []int{1, 2}) {
+		t.Errorf("TakeList: got %v", result)
+	}
+	if result := DropList(l, 3).ToSlice(); !reflect.DeepEqual(result, []int{4}) {
+		t.Errorf("DropList: got %v", result)
+	}
+	if result := TakeList(l, -1); !result.IsEmpty() {
+		t.Errorf("TakeList: expected empty list, got %v", result)
+	}
+	if result := DropList(l, 10); !result.IsEmpty() {
+		t.Errorf("DropList: expected empty list, got %v", result)
+	}
+	if result := ReverseList(l).ToSlice(); !reflect.DeepEqual(result, []int{4, 3, 2, 1}) {
+		t.Errorf("ReverseList: got %v", result)
+	}
+
+	t.Run("filter shares passing suffix", func(t *testing.T) {
+		src := ListOf(1, 2, 3, 4, 6)
+		result := FilterList(func(x int) bool { return x > 2 }, src)
+		if result.node != DropList(src, 2).node {
+			t.Errorf("Expected the filtered list to share the suffix [3, 4, 6]")
+		}
+		if result := FilterList(func(x int) bool { return x != 3 }, src).ToSlice(); !reflect.DeepEqual(result, []int{1, 2, 4, 6}) {
+			t.Errorf("Expected [1 2 4 6], got %v", result)
+		}
+	})
+}
+
+func TestListCompare(t *testing.T) {
+	tests := []struct {
+		a, b     List[int]
+		expected ComparisonResult
+	}{
+		{ListOf(1, 2), ListOf(1, 2), EQ},
+		{ListOf(1, 2), ListOf(1, 3), LT},
+		{ListOf(1), ListOf(1, 0), LT},
+		{ListOf(2), ListOf(1, 9), GT},
+		{List[int]{}, List[int]{}, EQ},
+	}
+	for _, tc := range tests {
+		if result := Compare(tc.a, tc.b); result != tc.expected {
+			t.Errorf("Compare(%v, %v): expected %v, got %v", tc.a, tc.b, tc.expected, result)
+		}
+	}
+}
+
+func TestListLarge(t *testing.T) {
+	l := ListFromSeq(TakeSeq(naturals(), 1_000_000))
+	if l.Len() != 1_000_000 || FoldlList(func(acc, x int) int { return acc + x }, 0, l) != 499999500000 {
+		t.Errorf("Expected a million-element list to fold without recursion")
+	}
+}
@@ -0,0 +1,170 @@
+package functionalgo
+
+// The map combinators never modify their inputs. Wherever the result depends
+// on iteration order (slices, folds and key collisions), keys are visited in
+// ascending Compare order so results are deterministic.
+
+// FlattenSorted is Flatten with the pairs sorted by key.
+func FlattenSorted[K comparable, V any](src map[K]V) []Tuple[K, V] {
+	return Map(func(k K) Tuple[K, V] { return MkTuple(k, src[k]) }, Keys(src))
+}
+
+// Unflatten builds a map from key-value pairs. Later pairs overwrite earlier
+// pairs with the same key.
+func Unflatten[K comparable, V any](src []Tuple[K, V]) map[K]V {
+	return FromTuples(func(_, v V) V { return v }, src)
+}
+
+// FromTuples builds a map from key-value pairs, combining the values of
+// duplicate keys with resolve(existing, incoming).
+func FromTuples[K comparable, V any](resolve func(V, V) V, src []Tuple[K, V]) map[K]V {
+	result := make(map[K]V, len(src))
+	for _, t := range src {
+		if old, ok := result[t.fst]; ok {
+			result[t.fst] = resolve(old, t.snd)
+		} else {
+			result[t.fst] = t.snd
+		}
+	}
+	return result
+}
+
+// Keys returns the keys of src in ascending order.
+func Keys[K comparable, V any](src map[K]V) []K {
+	keys := make([]K, 0, len(src))
+	for k := range src {
+		keys = append(keys, k)
+	}
+	return SortBy(Compare[K], keys)
+}
+
+// Elems returns the values of src in the order of their keys.
+func Elems[K comparable, V any](src map[K]V) []V {
+	return Map(func(k K) V { return src[k] }, Keys(src))
+}
+
+func MapValues[K comparable, V any, W any](fn func(V) W, src map[K]V) map[K]W {
+	result := make(map[K]W, len(src))
+	for k, v := range src {
+		result[k] = fn(v)
+	}
+	return result
+}
+
+// MapKeys applies fn to every key. When several keys map to the same new key,
+// the value of the greatest original key is kept.
+func MapKeys[K comparable, V any, J comparable](fn func(K) J, src map[K]V) map[J]V {
+	return MapKeysWith(func(_, v V) V { return v }, fn, src)
+}
+
+// MapKeysWith is MapKeys combining the values of colliding keys with
+// resolve(existing, incoming), in ascending order of the original keys.
+func MapKeysWith[K comparable, V any, J comparable](resolve func(V, V) V, fn func(K) J, src map[K]V) map[J]V {
+	return FromTuples(resolve, Map(func(t Tuple[K, V]) Tuple[J, V] {
+		return MkTuple(fn(t.fst), t.snd)
+	}, FlattenSorted(src)))
+}
+
+func FilterWithKey[K comparable, V any](fn func(K, V) bool, src map[K]V) map[K]V {
+	result := map[K]V{}
+	for k, v := range src {
+		if fn(k, v) {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// FoldWithKey folds over the entries of src in ascending key order.
+func FoldWithKey[K comparable, V any, B any](fn func(B, K, V) B, acc B, src map[K]V) B {
+	for _, k := range Keys(src) {
+		acc = fn(acc, k, src[k])
+	}
+	return acc
+}
+
+// UnionWith returns every entry of a and b, combining the values of keys in
+// both with fn(a[k], b[k]).
+func UnionWith[K comparable, V any](fn func(V, V) V, a, b map[K]V) map[K]V {
+	result := make(map[K]V, max(len(a), len(b)))
+	for k, v := range a {
+		result[k] = v
+	}
+	for k, v := range b {
+		if old, ok := result[k]; ok {
+			result[k] = fn(old, v)
+		} else {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// IntersectionWith keeps the keys present in both maps, combining their values
+// with fn(a[k], b[k]).
+func IntersectionWith[K comparable, V any, W any, X any](fn func(V, W) X, a map[K]V, b map[K]W) map[K]X {
+	result := map[K]X{}
+	for k, v := range a {
+		if w, ok := b[k]; ok {
+			result[k] = fn(v, w)
+		}
+	}
+	return result
+}
+
+// DifferenceWith keeps the entries of a whose keys are not in b. For keys in
+// both, fn(a[k], b[k]) decides: None drops the entry, Some replaces its value.
+func DifferenceWith[K comparable, V any, W any](fn func(V, W) Option[V], a map[K]V, b map[K]W) map[K]V {
+	result := map[K]V{}
+	for k, v := range a {
+		w, ok := b[k]
+		if !ok {
+			result[k] = v
+		} else if nv, keep := fn(v, w).Get(); keep {
+			result[k] = nv
+		}
+	}
+	return result
+}
+
+// Alter returns a copy of src with the entry for key inserted, updated or
+// removed. fn receives the current value, or None if key is absent, and
+// returns the new value, or None to remove the entry.
+func Alter[K comparable, V any](fn func(Option[V]) Option[V], key K, src map[K]V) map[K]V {
+	result := cloneMap(src)
+	old, ok := src[key]
+	current := None[V]()
+	if ok {
+		current = Some(old)
+	}
+	if v, keep := fn(current).Get(); keep {
+		result[key] = v
+	} else {
+		delete(result, key)
+	}
+	return result
+}
+
+// Adjust returns a copy of src with fn applied to the value of key, if
+// present.
+func Adjust[K comparable, V any](fn func(V) V, key K, src map[K]V) map[K]V {
+	result := cloneMap(src)
+	if v, ok := src[key]; ok {
+		result[key] = fn(v)
+	}
+	return result
+}
+
+// Invert swaps keys and values. When several keys share a value, the greatest
+// key is kept.
+func Invert[K comparable, V comparable](src map[K]V) map[V]K {
+	return Unflatten(Map(Swap[K, V], FlattenSorted(src)))
+}
+
+func cloneMap[K comparable, V any](src map[K]V) map[K]V {
+	result := make(map[K]V, len(src))
+	for k, v := range src {
+		result[k] = v
+	}
+	return result
+}
@@ -0,0 +1,137 @@
+package functionalgo
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFlattenSorted(t *testing.T) {
+	input := map[string]int{"cherry": 7, "apple": 5, "banana": 3}
+	result := FlattenSorted(input)
+	expected := []Tuple[string, int]{MkTuple("apple", 5), MkTuple("banana", 3), MkTuple("cherry", 7)}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+	if result := FlattenSorted(map[int]int{}); !reflect.DeepEqual(result, []Tuple[int, int]{}) {
+		t.Errorf("Expected [], got %v", result)
+	}
+	if !reflect.DeepEqual(Unflatten(result), input) {
+		t.Errorf("Expected Unflatten to invert FlattenSorted, got %v", Unflatten(result))
+	}
+}
+
+func TestFromTuples(t *testing.T) {
+	pairs := []Tuple[string, int]{MkTuple("a", 1), MkTuple("b", 2), MkTuple("a", 3)}
+	if result := Unflatten(pairs); !reflect.DeepEqual(result, map[string]int{"a": 3, "b": 2}) {
+		t.Errorf("Expected later pairs to win, got %v", result)
+	}
+	var order []int
+	result := FromTuples(func(old, new int) int { order = append(order, old, new); return old + new }, pairs)
+	if !reflect.DeepEqual(result, map[string]int{"a": 4, "b": 2}) {
+		t.Errorf("Expected map[a:4 b:2], got %v", result)
+	}
+	if !reflect.DeepEqual(order, []int{1, 3}) {
+		t.Errorf("Expected resolve(existing, incoming), got arguments %v", order)
+	}
+}
+
+func TestKeysElems(t *testing.T) {
+	input := map[int]string{3: "c", 1: "a", 2: "b"}
+	if result := Keys(input); !reflect.DeepEqual(result, []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", result)
+	}
+	if result := Elems(input); !reflect.DeepEqual(result, []string{"a", "b", "c"}) {
+		t.Errorf("Expected [a b c], got %v", result)
+	}
+}
+
+func TestMapValuesKeys(t *testing.T) {
+	input := map[string]int{"a": 1, "B": 2, "b": 3}
+	if result := MapValues(func(v int) bool { return v%2 == 1 }, input); !reflect.DeepEqual(result, map[string]bool{"a": true, "B": false, "b": true}) {
+		t.Errorf("Unexpected MapValues result %v", result)
+	}
+
+	t.Run("collisions keep the greatest key", func(t *testing.T) {
+		result := MapKeys(strings.ToLower, input)
+		if !reflect.DeepEqual(result, map[string]int{"a": 1, "b": 3}) {
+			t.Errorf("Expected map[a:1 b:3], got %v", result)
+		}
+	})
+
+	t.Run("collisions combined in key order", func(t *testing.T) {
+		result := MapKeysWith(func(old, new int) int { return old*10 + new }, strings.ToLower, input)
+		if !reflect.DeepEqual(result, map[string]int{"a": 1, "b": 23}) {
+			t.Errorf("Expected map[a:1 b:23], got %v", result)
+		}
+	})
+}
+
+func TestFilterFoldWithKey(t *testing.T) {
+	input := map[string]int{"a": 1, "bb": 1, "ccc": 5}
+	result := FilterWithKey(func(k string, v int) bool { return len(k) == v }, input)
+	if !reflect.DeepEqual(result, map[string]int{"a": 1}) {
+		t.Errorf("Expected map[a:1], got %v", result)
+	}
+	joined := FoldWithKey(func(acc string, k string, v int) string { return acc + k }, "", input)
+	if joined != "abbccc" {
+		t.Errorf("Expected keys folded in order, got %q", joined)
+	}
+}
+
+func TestMapSetOperations(t *testing.T) {
+	a := map[string]int{"x": 1, "y": 2}
+	b := map[string]int{"y": 10, "z": 20}
+	sub := func(v, w int) int { return v - w }
+
+	if result := UnionWith(sub, a, b); !reflect.DeepEqual(result, map[string]int{"x": 1, "y": -8, "z": 20}) {
+		t.Errorf("UnionWith: got %v", result)
+	}
+	if result := IntersectionWith(func(v, w int) string { return strings.Repeat("*", v+w) }, a, b); !reflect.DeepEqual(result, map[string]string{"y": strings.Repeat("*", 12)}) {
+		t.Errorf("IntersectionWith: got %v", result)
+	}
+	if result := DifferenceWith(func(v, w int) Option[int] { return None[int]() }, a, b); !reflect.DeepEqual(result, map[string]int{"x": 1}) {
+		t.Errorf("DifferenceWith dropping: got %v", result)
+	}
+	if result := DifferenceWith(func(v, w int) Option[int] { return Some(v * w) }, a, b); !reflect.DeepEqual(result, map[string]int{"x": 1, "y": 20}) {
+		t.Errorf("DifferenceWith replacing: got %v", result)
+	}
+	if !reflect.DeepEqual(a, map[string]int{"x": 1, "y": 2}) {
+		t.Errorf("Expected inputs to be unchanged, got %v", a)
+	}
+}
+
+func TestAlterAdjust(t *testing.T) {
+	input := map[string]int{"a": 1}
+	increment := func(o Option[int]) Option[int] { return Some(o.OrElse(0) + 1) }
+	remove := func(Option[int]) Option[int] { return None[int]() }
+
+	tests := []struct {
+		name     string
+		result   map[string]int
+		expected map[string]int
+	}{
+		{"alter inserts", Alter(increment, "b", input), map[string]int{"a": 1, "b": 1}},
+		{"alter updates", Alter(increment, "a", input), map[string]int{"a": 2}},
+		{"alter removes", Alter(remove, "a", input), map[string]int{}},
+		{"adjust present", Adjust(func(v int) int { return v * 5 }, "a", input), map[string]int{"a": 5}},
+		{"adjust absent", Adjust(func(v int) int { return v * 5 }, "z", input), map[string]int{"a": 1}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tc.result, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, tc.result)
+			}
+		})
+	}
+	if !reflect.DeepEqual(input, map[string]int{"a": 1}) {
+		t.Errorf("Expected input to be unchanged, got %v", input)
+	}
+}
+
+func TestInvert(t *testing.T) {
+	result := Invert(map[string]int{"one": 1, "uno": 1, "two": 2})
+	if !reflect.DeepEqual(result, map[int]string{1: "uno", 2: "two"}) {
+		t.Errorf("Expected map[1:uno 2:two], got %v", result)
+	}
+}
@@ -0,0 +1,124 @@
+package functionalgo
+
+import "fmt"
+
+// Option holds either a value (Some) or nothing (None). The zero value is None.
+type Option[T any] struct {
+	value T
+	ok    bool
+}
+
+func Some[T any](v T) Option[T] {
+	return Option[T]{value: v, ok: true}
+}
+
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+func (o Option[T]) IsSome() bool {
+	return o.ok
+}
+
+func (o Option[T]) IsNone() bool {
+	return !o.ok
+}
+
+func (o Option[T]) Get() (T, bool) {
+	return o.value, o.ok
+}
+
+func (o Option[T]) Unwrap() T {
+	if !o.ok {
+		panic("called Unwrap on None")
+	}
+	return o.value
+}
+
+func (o Option[T]) OrElse(def T) T {
+	if o.ok {
+		return o.value
+	}
+	return def
+}
+
+func (o Option[T]) OrElseGet(fn func() T) T {
+	if o.ok {
+		return o.value
+	}
+	return fn()
+}
+
+func (o Option[T]) String() string {
+	if o.ok {
+		return fmt.Sprintf("Some(%v)", o.value)
+	}
+	return "None"
+}
+
+func MapOption[A any, B any](fn func(A) B, o Option[A]) Option[B] {
+	if !o.ok {
+		return None[B]()
+	}
+	return Some(fn(o.value))
+}
+
+func FlatMapOption[A any, B any](fn func(A) Option[B], o Option[A]) Option[B] {
+	if !o.ok {
+		return None[B]()
+	}
+	return fn(o.value)
+}
+
+func MatchOption[A any, B any](some func(A) B, none func() B, o Option[A]) B {
+	if o.ok {
+		return some(o.value)
+	}
+	return none()
+}
+
+func SafeHead[A any](src []A) Option[A] {
+	if len(src) == 0 {
+		return None[A]()
+	}
+	return Some(src[0])
+}
+
+func SafeTail[A any](src []A) Option[[]A] {
+	if len(src) == 0 {
+		return None[[]A]()
+	}
+	return Some(src[1:])
+}
+
+func SafeLast[A any](src []A) Option[A] {
+	if len(src) == 0 {
+		return None[A]()
+	}
+	return Some(src[len(src)-1])
+}
+
+func SafeMaximum[A any](src []A) Option[A] {
+	if len(src) == 0 {
+		return None[A]()
+	}
+	return Some(Maximum(src))
+}
+
+func SafeMinimum[A any](src []A) Option[A] {
+	if len(src) == 0 {
+		return None[A]()
+	}
+	return Some(Minimum(src))
+}
+
+// GuardsOpt is Guards without the exhaustiveness requirement: it returns None
+// instead of panicking when no guard matches.
+func GuardsOpt[T any](guards ...GuardS[T]) Option[T] {
+	for _, v := range guards {
+		if v.cond {
+			return Some(v.fn())
+		}
+	}
+	return None[T]()
+}
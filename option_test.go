@@ -0,0 +1,177 @@
+package functionalgo
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestOption(t *testing.T) {
+	t.Run("some holds a value", func(t *testing.T) {
+		o := Some(42)
+		if !o.IsSome() || o.IsNone() {
+			t.Errorf("Expected Some(42) to be Some")
+		}
+		if v, ok := o.Get(); !ok || v != 42 {
+			t.Errorf("Expected (42, true), got (%v, %v)", v, ok)
+		}
+		if o.Unwrap() != 42 {
+			t.Errorf("Expected 42, got %v", o.Unwrap())
+		}
+		if o.OrElse(0) != 42 {
+			t.Errorf("Expected 42, got %v", o.OrElse(0))
+		}
+	})
+
+	t.Run("zero value is none", func(t *testing.T) {
+		var o Option[string]
+		if o.IsSome() || !o.IsNone() {
+			t.Errorf("Expected zero Option to be None")
+		}
+		if o.OrElse("default") != "default" {
+			t.Errorf("Expected default, got %v", o.OrElse("default"))
+		}
+		if o.OrElseGet(func() string { return "lazy" }) != "lazy" {
+			t.Errorf("Expected lazy, got %v", o.OrElseGet(func() string { return "lazy" }))
+		}
+	})
+
+	t.Run("unwrap none panics", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("Expected Unwrap on None to panic")
+			}
+		}()
+		None[int]().Unwrap()
+	})
+
+	t.Run("string", func(t *testing.T) {
+		if Some(1).String() != "Some(1)" {
+			t.Errorf("Expected Some(1), got %v", Some(1).String())
+		}
+		if None[int]().String() != "None" {
+			t.Errorf("Expected None, got %v", None[int]().String())
+		}
+	})
+}
+
+func TestMapOption(t *testing.T) {
+	result := MapOption(strconv.Itoa, Some(7))
+	if result != Some("7") {
+		t.Errorf("Expected %v, got %v", Some("7"), result)
+	}
+	if MapOption(strconv.Itoa, None[int]()).IsSome() {
+		t.Errorf("Expected mapping None to stay None")
+	}
+}
+
+func TestFlatMapOption(t *testing.T) {
+	parse := func(s string) Option[int] {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return None[int]()
+		}
+		return Some(n)
+	}
+	if result := FlatMapOption(parse, Some("12")); result != Some(12) {
+		t.Errorf("Expected %v, got %v", Some(12), result)
+	}
+	if result := FlatMapOption(parse, Some("x")); result.IsSome() {
+		t.Errorf("Expected None, got %v", result)
+	}
+	if result := FlatMapOption(parse, None[string]()); result.IsSome() {
+		t.Errorf("Expected None, got %v", result)
+	}
+}
+
+func TestMatchOption(t *testing.T) {
+	describe := func(o Option[int]) string {
+		return MatchOption(
+			func(v int) string { return "got " + strconv.Itoa(v) },
+			func() string { return "nothing" },
+			o,
+		)
+	}
+	if describe(Some(3)) != "got 3" {
+		t.Errorf("Expected 'got 3', got %v", describe(Some(3)))
+	}
+	if describe(None[int]()) != "nothing" {
+		t.Errorf("Expected 'nothing', got %v", describe(None[int]()))
+	}
+}
+
+func TestSafeFunctions(t *testing.T) {
+	t.Run("safe head", func(t *testing.T) {
+		if SafeHead([]int{1, 2}) != Some(1) {
+			t.Errorf("Expected Some(1), got %v", SafeHead([]int{1, 2}))
+		}
+		if SafeHead([]int{}).IsSome() {
+			t.Errorf("Expected None for empty slice")
+		}
+	})
+
+	t.Run("safe tail", func(t *testing.T) {
+		result, ok := SafeTail([]int{1, 2, 3}).Get()
+		if !ok || !reflect.DeepEqual(result, []int{2, 3}) {
+			t.Errorf("Expected [2 3], got %v", result)
+		}
+		if SafeTail([]int{}).IsSome() {
+			t.Errorf("Expected None for empty slice")
+		}
+	})
+
+	t.Run("safe last", func(t *testing.T) {
+		if SafeLast([]string{"a", "b"}) != Some("b") {
+			t.Errorf("Expected Some(b), got %v", SafeLast([]string{"a", "b"}))
+		}
+		if SafeLast([]string{}).IsSome() {
+			t.Errorf("Expected None for empty slice")
+		}
+	})
+
+	t.Run("safe maximum and minimum", func(t *testing.T) {
+		if SafeMaximum([]int{3, 9, 1}) != Some(9) {
+			t.Errorf("Expected Some(9), got %v", SafeMaximum([]int{3, 9, 1}))
+		}
+		if SafeMinimum([]int{3, 9, 1}) != Some(1) {
+			t.Errorf("Expected Some(1), got %v", SafeMinimum([]int{3, 9, 1}))
+		}
+		if SafeMaximum([]int{}).IsSome() || SafeMinimum([]int{}).IsSome() {
+			t.Errorf("Expected None for empty slice")
+		}
+	})
+}
+
+func TestFind(t *testing.T) {
+	if result := Find(func(x int) bool { return x > 2 }, []int{1, 3, 5}); result != Some(3) {
+		t.Errorf("Expected Some(3), got %v", result)
+	}
+	if result := Find(func(x int) bool { return x > 10 }, []int{1, 3, 5}); result.IsSome() {
+		t.Errorf("Expected None, got %v", result)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	assoc := []Tuple[string, int]{{fst: "a", snd: 1}, {fst: "b", snd: 2}, {fst: "a", snd: 3}}
+	if result := Lookup("a", assoc); result != Some(1) {
+		t.Errorf("Expected Some(1), got %v", result)
+	}
+	if result := Lookup("z", assoc); result.IsSome() {
+		t.Errorf("Expected None, got %v", result)
+	}
+}
+
+func TestGuardsOpt(t *testing.T) {
+	result := GuardsOpt(
+		Guard(false, func() int { return 1 }),
+		Guard(true, func() int { return 2 }),
+	)
+	if result != Some(2) {
+		t.Errorf("Expected Some(2), got %v", result)
+	}
+
+	result = GuardsOpt(Guard(false, func() int { return 1 }))
+	if result.IsSome() {
+		t.Errorf("Expected None, got %v", result)
+	}
+}
@@ -0,0 +1,93 @@
+package functionalgo
+
+// Queue is a persistent FIFO queue using Okasaki's banker's method. PushBack,
+// PopFront and PeekFront are amortised O(1), even when old versions are
+// reused, because the reversal of the rear list is a memoised lazy stream
+// shared by every version that reaches it. The zero value is an empty queue.
+type Queue[T any] struct {
+	front    *stream[T]
+	lenFront int
+	rear     List[T]
+}
+
+func QueueOf[T any](xs ...T) Queue[T] {
+	return QueueFromSlice(xs)
+}
+
+func QueueFromSlice[T any](src []T) Queue[T] {
+	return Queue[T]{front: sliceStream(src), lenFront: len(src)}
+}
+
+// checkQueue keeps the rear no longer than the front by scheduling the rear
+// to be reversed onto the end of the front.
+func checkQueue[T any](front *stream[T], lenFront int, rear List[T]) Queue[T] {
+	if rear.Len() <= lenFront {
+		return Queue[T]{front, lenFront, rear}
+	}
+	reversed := lazyStream(func() *streamCell[T] {
+		var s *stream[T]
+		for x := range rear.Seq() {
+			s = consStream(x, s)
+		}
+		return s.force()
+	})
+	return Queue[T]{appendStream(front, reversed), lenFront + rear.Len(), List[T]{}}
+}
+
+func (q Queue[T]) Len() int {
+	return q.lenFront + q.rear.Len()
+}
+
+func (q Queue[T]) IsEmpty() bool {
+	return q.Len() == 0
+}
+
+func (q Queue[T]) PushBack(x T) Queue[T] {
+	return checkQueue(q.front, q.lenFront, Cons(x, q.rear))
+}
+
+func (q Queue[T]) PeekFront() Option[T] {
+	c := q.front.force()
+	if c == nil {
+		return None[T]()
+	}
+	return Some(c.head)
+}
+
+// PopFront returns the front element, or None if q is empty, and the queue
+// without it.
+func (q Queue[T]) PopFront() (Option[T], Queue[T]) {
+	c := q.front.force()
+	if c == nil {
+		return None[T](), q
+	}
+	return Some(c.head), checkQueue(c.tail, q.lenFront-1, q.rear)
+}
+
+// Seq yields the elements from front to back.
+func (q Queue[T]) Seq() Seq[T] {
+	return func(yield func(T) bool) {
+		for x := range q.front.seq() {
+			if !yield(x) {
+				return
+			}
+		}
+		for _, x := range Reverse(q.rear.ToSlice()) {
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+func (q Queue[T]) ToSlice() []T {
+	return AppendSeq(make([]T, 0, q.Len()), q.Seq())
+}
+
+func MapQueue[A any, B any](fn func(A) B, q Queue[A]) Queue[B] {
+	return QueueFromSlice(Map(fn, q.ToSlice()))
+}
+
+func FoldlQueue[A any, B any](fn func(B, A) B, acc B, q Queue[A]) B {
+	return FoldlSeq(fn, acc, q.Seq())
+}
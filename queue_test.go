@@ -0,0 +1,79 @@
+package functionalgo
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestQueueBasics(t *testing.T) {
+	var empty Queue[int]
+	if !empty.IsEmpty() || empty.Len() != 0 || empty.PeekFront().IsSome() {
+		t.Errorf("Expected the zero value to be an empty queue")
+	}
+	if x, rest := empty.PopFront(); x.IsSome() || !rest.IsEmpty() {
+		t.Errorf("Expected popping an empty queue to return None")
+	}
+
+	q := QueueOf(1, 2).PushBack(3).PushBack(4)
+	if result := q.ToSlice(); !reflect.DeepEqual(result, []int{1, 2, 3, 4}) {
+		t.Errorf("Expected [1 2 3 4], got %v", result)
+	}
+	x, rest := q.PopFront()
+	if x != Some(1) || rest.Len() != 3 || rest.PeekFront() != Some(2) {
+		t.Errorf("Expected Some(1) and a queue starting at 2, got %v and %v", x, rest.ToSlice())
+	}
+	if q.Len() != 4 || q.PeekFront() != Some(1) {
+		t.Errorf("Expected PopFront to leave the original queue intact")
+	}
+}
+
+func TestQueueCombinators(t *testing.T) {
+	q := QueueOf(1, 2, 3).PushBack(4)
+	if result := MapQueue(func(x int) int { return x * 10 }, q).ToSlice(); !reflect.DeepEqual(result, []int{10, 20, 30, 40}) {
+		t.Errorf("Expected [10 20 30 40], got %v", result)
+	}
+	if result := FoldlQueue(func(acc string, x int) string { return acc + string(rune('0'+x)) }, "", q); result != "1234" {
+		t.Errorf("Expected 1234, got %s", result)
+	}
+	if result := Collect(TakeSeq(q.Seq(), 2)); !reflect.DeepEqual(result, []int{1, 2}) {
+		t.Errorf("Expected [1 2], got %v", result)
+	}
+}
+
+func TestQueueRandomOps(t *testing.T) {
+	rng := rand.New(rand.NewPCG(5, 6))
+	type version struct {
+		q     Queue[int]
+		model []int
+	}
+	versions := []version{{}}
+	for step := range 3000 {
+		v := versions[rng.IntN(len(versions))]
+		if rng.IntN(3) == 0 {
+			x, q := v.q.PopFront()
+			if len(v.model) == 0 {
+				if x.IsSome() {
+					t.Fatalf("Step %d: expected None from an empty queue", step)
+				}
+				continue
+			}
+			if x != Some(v.model[0]) {
+				t.Fatalf("Step %d: expected Some(%d), got %v", step, v.model[0], x)
+			}
+			v = version{q, v.model[1:]}
+		} else {
+			v = version{v.q.PushBack(step), append(slices.Clone(v.model), step)}
+		}
+		if v.q.Len() != len(v.model) {
+			t.Fatalf("Step %d: expected length %d, got %d", step, len(v.model), v.q.Len())
+		}
+		versions = append(versions, v)
+	}
+	for _, v := range versions {
+		if !slices.Equal(v.q.ToSlice(), v.model) {
+			t.Fatalf("Expected %v, got %v", v.model, v.q.ToSlice())
+		}
+	}
+}
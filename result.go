@@ -0,0 +1,163 @@
+package functionalgo
+
+import "fmt"
+
+// Result holds either a value (Ok) or an error (Err). The zero value is Ok
+// with the zero value of T.
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// PanicError is the error produced by Try when the wrapped function panics.
+type PanicError struct {
+	Value any
+}
+
+func (p *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", p.Value)
+}
+
+func (p *PanicError) Unwrap() error {
+	err, _ := p.Value.(error)
+	return err
+}
+
+func Ok[T any](v T) Result[T] {
+	return Result[T]{value: v}
+}
+
+func Err[T any](err error) Result[T] {
+	if err == nil {
+		panic("called Err with nil error")
+	}
+	return Result[T]{err: err}
+}
+
+// ResultOf converts a conventional (value, error) pair into a Result.
+func ResultOf[T any](v T, err error) Result[T] {
+	if err != nil {
+		return Result[T]{err: err}
+	}
+	return Result[T]{value: v}
+}
+
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+func (r Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+func (r Result[T]) Get() (T, error) {
+	return r.value, r.err
+}
+
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+func (r Result[T]) Unwrap() T {
+	if r.err != nil {
+		panic(fmt.Sprintf("called Unwrap on Err: %v", r.err))
+	}
+	return r.value
+}
+
+func (r Result[T]) OrElse(def T) T {
+	if r.err != nil {
+		return def
+	}
+	return r.value
+}
+
+func (r Result[T]) ToOption() Option[T] {
+	if r.err != nil {
+		return None[T]()
+	}
+	return Some(r.value)
+}
+
+// MapErr transforms the error of a failed Result. A Result without a value
+// cannot become Ok, so if fn returns nil the original error is kept, wrapped
+// in one saying so.
+func (r Result[T]) MapErr(fn func(error) error) Result[T] {
+	if r.err == nil {
+		return r
+	}
+	if err := fn(r.err); err != nil {
+		return Err[T](err)
+	}
+	return Err[T](fmt.Errorf("MapErr: fn returned nil for error: %w", r.err))
+}
+
+func (r Result[T]) Recover(fn func(error) T) Result[T] {
+	if r.err == nil {
+		return r
+	}
+	return Ok(fn(r.err))
+}
+
+func (r Result[T]) String() string {
+	if r.err != nil {
+		return fmt.Sprintf("Err(%v)", r.err)
+	}
+	return fmt.Sprintf("Ok(%v)", r.value)
+}
+
+func MapResult[A any, B any](fn func(A) B, r Result[A]) Result[B] {
+	if r.err != nil {
+		return Result[B]{err: r.err}
+	}
+	return Ok(fn(r.value))
+}
+
+func FlatMapResult[A any, B any](fn func(A) Result[B], r Result[A]) Result[B] {
+	if r.err != nil {
+		return Result[B]{err: r.err}
+	}
+	return fn(r.value)
+}
+
+func MatchResult[A any, B any](ok func(A) B, fail func(error) B, r Result[A]) B {
+	if r.err != nil {
+		return fail(r.err)
+	}
+	return ok(r.value)
+}
+
+// ComposeResult composes two fallible functions, short-circuiting on the
+// first error.
+func ComposeResult[A any, B any, C any](fnB func(B) Result[C], fnA func(A) Result[B]) func(A) Result[C] {
+	return func(a A) Result[C] {
+		return FlatMapResult(fnB, fnA(a))
+	}
+}
+
+// TraverseResult applies fn to every element and stops at the first error.
+func TraverseResult[A any, B any](fn func(A) Result[B], src []A) Result[[]B] {
+	result := make([]B, 0, len(src))
+	for _, v := range src {
+		r := fn(v)
+		if r.err != nil {
+			return Result[[]B]{err: r.err}
+		}
+		result = append(result, r.value)
+	}
+	return Ok(result)
+}
+
+func SequenceResult[A any](src []Result[A]) Result[[]A] {
+	return TraverseResult(func(r Result[A]) Result[A] { return r }, src)
+}
+
+// Try runs fn and converts a panic into an Err holding a *PanicError.
+func Try[T any](fn func() T) (result Result[T]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = Err[T](&PanicError{Value: r})
+		}
+	}()
+	return Ok(fn())
+}
@@ -0,0 +1,211 @@
+package functionalgo
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+var errTest = errors.New("boom")
+
+func TestResult(t *testing.T) {
+	t.Run("ok holds a value", func(t *testing.T) {
+		r := Ok(5)
+		if !r.IsOk() || r.IsErr() {
+			t.Errorf("Expected Ok(5) to be Ok")
+		}
+		if v, err := r.Get(); v != 5 || err != nil {
+			t.Errorf("Expected (5, nil), got (%v, %v)", v, err)
+		}
+		if r.Unwrap() != 5 {
+			t.Errorf("Expected 5, got %v", r.Unwrap())
+		}
+	})
+
+	t.Run("err holds an error", func(t *testing.T) {
+		r := Err[int](errTest)
+		if r.IsOk() || !r.IsErr() {
+			t.Errorf("Expected Err to be Err")
+		}
+		if r.Err() != errTest {
+			t.Errorf("Expected %v, got %v", errTest, r.Err())
+		}
+		if r.OrElse(9) != 9 {
+			t.Errorf("Expected 9, got %v", r.OrElse(9))
+		}
+		if r.ToOption().IsSome() {
+			t.Errorf("Expected None from Err")
+		}
+	})
+
+	t.Run("result of value and error", func(t *testing.T) {
+		if r := ResultOf(strconv.Atoi("12")); r != Ok(12) {
+			t.Errorf("Expected Ok(12), got %v", r)
+		}
+		if r := ResultOf(strconv.Atoi("x")); r.IsOk() {
+			t.Errorf("Expected Err, got %v", r)
+		}
+	})
+
+	t.Run("unwrap err panics", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("Expected Unwrap on Err to panic")
+			}
+		}()
+		Err[int](errTest).Unwrap()
+	})
+
+	t.Run("err with nil panics", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("Expected Err(nil) to panic")
+			}
+		}()
+		Err[int](nil)
+	})
+
+	t.Run("string", func(t *testing.T) {
+		if Ok(1).String() != "Ok(1)" {
+			t.Errorf("Expected Ok(1), got %v", Ok(1).String())
+		}
+		if Err[int](errTest).String() != "Err(boom)" {
+			t.Errorf("Expected Err(boom), got %v", Err[int](errTest).String())
+		}
+	})
+}
+
+func TestMapResult(t *testing.T) {
+	if r := MapResult(strconv.Itoa, Ok(3)); r != Ok("3") {
+		t.Errorf("Expected Ok(3), got %v", r)
+	}
+	if r := MapResult(strconv.Itoa, Err[int](errTest)); r.Err() != errTest {
+		t.Errorf("Expected error to propagate, got %v", r)
+	}
+}
+
+func TestFlatMapResult(t *testing.T) {
+	parse := func(s string) Result[int] { return ResultOf(strconv.Atoi(s)) }
+	if r := FlatMapResult(parse, Ok("4")); r != Ok(4) {
+		t.Errorf("Expected Ok(4), got %v", r)
+	}
+	if r := FlatMapResult(parse, Ok("four")); r.IsOk() {
+		t.Errorf("Expected Err, got %v", r)
+	}
+	if r := FlatMapResult(parse, Err[string](errTest)); r.Err() != errTest {
+		t.Errorf("Expected error to propagate, got %v", r)
+	}
+}
+
+func TestMapErrAndRecover(t *testing.T) {
+	wrapped := Err[int](errTest).MapErr(func(err error) error { return errors.Join(errors.New("ctx"), err) })
+	if !errors.Is(wrapped.Err(), errTest) {
+		t.Errorf("Expected wrapped error to contain errTest, got %v", wrapped.Err())
+	}
+	if r := Ok(1).MapErr(func(error) error { return errTest }); r != Ok(1) {
+		t.Errorf("Expected Ok(1), got %v", r)
+	}
+	dropped := Err[int](errTest).MapErr(func(error) error { return nil })
+	if !dropped.IsErr() || !errors.Is(dropped.Err(), errTest) {
+		t.Errorf("Expected an error wrapping errTest when fn returns nil, got %v", dropped)
+	}
+
+	recovered := Err[int](errTest).Recover(func(error) int { return -1 })
+	if recovered != Ok(-1) {
+		t.Errorf("Expected Ok(-1), got %v", recovered)
+	}
+}
+
+func TestMatchResult(t *testing.T) {
+	describe := func(r Result[int]) string {
+		return MatchResult(strconv.Itoa, func(err error) string { return err.Error() }, r)
+	}
+	if describe(Ok(8)) != "8" {
+		t.Errorf("Expected 8, got %v", describe(Ok(8)))
+	}
+	if describe(Err[int](errTest)) != "boom" {
+		t.Errorf("Expected boom, got %v", describe(Err[int](errTest)))
+	}
+}
+
+func TestComposeResult(t *testing.T) {
+	parse := func(s string) Result[int] { return ResultOf(strconv.Atoi(s)) }
+	positive := func(n int) Result[int] {
+		if n <= 0 {
+			return Err[int](errTest)
+		}
+		return Ok(n)
+	}
+	fn := ComposeResult(positive, parse)
+	if r := fn("5"); r != Ok(5) {
+		t.Errorf("Expected Ok(5), got %v", r)
+	}
+	if r := fn("-5"); r.Err() != errTest {
+		t.Errorf("Expected errTest, got %v", r)
+	}
+	if r := fn("x"); r.IsOk() {
+		t.Errorf("Expected Err, got %v", r)
+	}
+}
+
+func TestTraverseResult(t *testing.T) {
+	parse := func(s string) Result[int] { return ResultOf(strconv.Atoi(s)) }
+
+	t.Run("all succeed", func(t *testing.T) {
+		result, err := TraverseResult(parse, []string{"1", "2", "3"}).Get()
+		if err != nil || !reflect.DeepEqual(result, []int{1, 2, 3}) {
+			t.Errorf("Expected [1 2 3], got %v (%v)", result, err)
+		}
+	})
+
+	t.Run("fails fast", func(t *testing.T) {
+		calls := 0
+		r := TraverseResult(func(s string) Result[int] {
+			calls++
+			return parse(s)
+		}, []string{"1", "x", "3"})
+		if r.IsOk() {
+			t.Errorf("Expected Err, got %v", r)
+		}
+		if calls != 2 {
+			t.Errorf("Expected 2 calls, got %d", calls)
+		}
+	})
+}
+
+func TestSequenceResult(t *testing.T) {
+	result, err := SequenceResult([]Result[int]{Ok(1), Ok(2)}).Get()
+	if err != nil || !reflect.DeepEqual(result, []int{1, 2}) {
+		t.Errorf("Expected [1 2], got %v (%v)", result, err)
+	}
+	if r := SequenceResult([]Result[int]{Ok(1), Err[int](errTest)}); r.Err() != errTest {
+		t.Errorf("Expected errTest, got %v", r)
+	}
+}
+
+func TestTry(t *testing.T) {
+	t.Run("no panic", func(t *testing.T) {
+		if r := Try(func() int { return Head([]int{4}) }); r != Ok(4) {
+			t.Errorf("Expected Ok(4), got %v", r)
+		}
+	})
+
+	t.Run("panic becomes error", func(t *testing.T) {
+		r := Try(func() int { return Head([]int{}) })
+		var p *PanicError
+		if !errors.As(r.Err(), &p) {
+			t.Fatalf("Expected PanicError, got %v", r.Err())
+		}
+		if p.Value != "cannot take head of empty list" {
+			t.Errorf("Expected panic value to be kept, got %v", p.Value)
+		}
+	})
+
+	t.Run("panic with error value unwraps", func(t *testing.T) {
+		r := Try(func() int { panic(errTest) })
+		if !errors.Is(r.Err(), errTest) {
+			t.Errorf("Expected error to unwrap to errTest, got %v", r.Err())
+		}
+	})
+}
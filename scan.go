@@ -0,0 +1,157 @@
+package functionalgo
+
+// Scanl returns the initial accumulator followed by every intermediate result
+// of Foldl, so its last element equals Foldl(fn, acc, src).
+func Scanl[A any, B any](fn func(B, A) B, acc B, src []A) []B {
+	result := make([]B, 0, len(src)+1)
+	result = append(result, acc)
+	for _, v := range src {
+		acc = fn(acc, v)
+		result = append(result, acc)
+	}
+	return result
+}
+
+// Scanl1 is Scanl using the first element as the initial accumulator.
+func Scanl1[A any](fn func(A, A) A, src []A) []A {
+	if len(src) == 0 {
+		return []A{}
+	}
+	return Scanl(fn, src[0], src[1:])
+}
+
+// Scanr is the right-to-left counterpart of Scanl: its first element equals
+// Foldr(fn, acc, src) and its last is acc.
+func Scanr[A any, B any](fn func(A, B) B, acc B, src []A) []B {
+	result := make([]B, len(src)+1)
+	result[len(src)] = acc
+	for i := len(src) - 1; i >= 0; i-- {
+		acc = fn(src[i], acc)
+		result[i] = acc
+	}
+	return result
+}
+
+func Scanr1[A any](fn func(A, A) A, src []A) []A {
+	if len(src) == 0 {
+		return []A{}
+	}
+	return Scanr(fn, src[len(src)-1], src[:len(src)-1])
+}
+
+// MapAccumL maps over src from the left while threading a state through
+// every call, returning the final state and the mapped values.
+func MapAccumL[S any, A any, B any](fn func(S, A) (S, B), acc S, src []A) (S, []B) {
+	result := make([]B, len(src))
+	for i, v := range src {
+		acc, result[i] = fn(acc, v)
+	}
+	return acc, result
+}
+
+// MapAccumR is MapAccumL threading the state from the right. The mapped
+// values keep the positions of their inputs.
+func MapAccumR[S any, A any, B any](fn func(S, A) (S, B), acc S, src []A) (S, []B) {
+	result := make([]B, len(src))
+	for i := len(src) - 1; i >= 0; i-- {
+		acc, result[i] = fn(acc, src[i])
+	}
+	return acc, result
+}
+
+// Iterate yields x, fn(x), fn(fn(x)), ... forever.
+func Iterate[A any](fn func(A) A, x A) Seq[A] {
+	return func(yield func(A) bool) {
+		for v := x; ; v = fn(v) {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Unfoldr builds a sequence from a seed. fn returns the next element and seed,
+// or false to end the sequence.
+func Unfoldr[A any, S any](fn func(S) (A, S, bool), seed S) Seq[A] {
+	return func(yield func(A) bool) {
+		for s := seed; ; {
+			v, next, ok := fn(s)
+			if !ok || !yield(v) {
+				return
+			}
+			s = next
+		}
+	}
+}
+
+func Repeat[A any](x A) Seq[A] {
+	return func(yield func(A) bool) {
+		for yield(x) {
+		}
+	}
+}
+
+// Cycle repeats the elements of src forever. Cycling an empty slice yields
+// nothing.
+func Cycle[A any](src []A) Seq[A] {
+	return func(yield func(A) bool) {
+		if len(src) == 0 {
+			return
+		}
+		for {
+			for _, v := range src {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func TakeSeq[A any](seq Seq[A], num int) Seq[A] {
+	return func(yield func(A) bool) {
+		if num <= 0 {
+			return
+		}
+		n := 0
+		for v := range seq {
+			if !yield(v) {
+				return
+			}
+			n++
+			if n == num {
+				return
+			}
+		}
+	}
+}
+
+func DropSeq[A any](seq Seq[A], num int) Seq[A] {
+	return func(yield func(A) bool) {
+		n := 0
+		for v := range seq {
+			if n < num {
+				n++
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func DropWhileSeq[A any](fn func(A) bool, seq Seq[A]) Seq[A] {
+	return func(yield func(A) bool) {
+		dropping := true
+		for v := range seq {
+			if dropping && fn(v) {
+				continue
+			}
+			dropping = false
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
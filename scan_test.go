@@ -0,0 +1,178 @@
+package functionalgo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestScanl(t *testing.T) {
+	t.Run("running totals", func(t *testing.T) {
+		result := Scanl(func(acc, x int) int { return acc + x }, 0, []int{1, 2, 3})
+		expected := []int{0, 1, 3, 6}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("last element equals foldl", func(t *testing.T) {
+		fn := func(acc string, x string) string { return "(" + acc + x + ")" }
+		src := []string{"a", "b", "c"}
+		if Last(Scanl(fn, "", src)) != Foldl(fn, "", src) {
+			t.Errorf("Expected last element of Scanl to equal Foldl")
+		}
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		result := Scanl(func(acc, x int) int { return acc + x }, 7, []int{})
+		if !reflect.DeepEqual(result, []int{7}) {
+			t.Errorf("Expected [7], got %v", result)
+		}
+	})
+}
+
+func TestScanl1(t *testing.T) {
+	result := Scanl1(func(acc, x int) int { return max(acc, x) }, []int{3, 1, 4, 1, 5})
+	expected := []int{3, 3, 4, 4, 5}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+	if result := Scanl1(func(acc, x int) int { return acc + x }, []int{}); !reflect.DeepEqual(result, []int{}) {
+		t.Errorf("Expected [], got %v", result)
+	}
+}
+
+func TestScanr(t *testing.T) {
+	t.Run("suffix sums", func(t *testing.T) {
+		result := Scanr(func(x, acc int) int { return x + acc }, 0, []int{1, 2, 3})
+		expected := []int{6, 5, 3, 0}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("first element equals foldr", func(t *testing.T) {
+		fn := func(x float64, acc float64) float64 { return x / acc }
+		src := []float64{1, 2, 3}
+		if Head(Scanr(fn, 1, src)) != Foldr(fn, 1, src) {
+			t.Errorf("Expected first element of Scanr to equal Foldr")
+		}
+	})
+}
+
+func TestScanr1(t *testing.T) {
+	result := Scanr1(func(x, acc int) int { return x + acc }, []int{1, 2, 3})
+	expected := []int{6, 5, 3}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+	if result := Scanr1(func(x, acc int) int { return x + acc }, []int{}); !reflect.DeepEqual(result, []int{}) {
+		t.Errorf("Expected [], got %v", result)
+	}
+}
+
+func TestMapAccumL(t *testing.T) {
+	total, running := MapAccumL(func(acc, x int) (int, int) { return acc + x, acc * x }, 0, []int{1, 2, 3})
+	if total != 6 {
+		t.Errorf("Expected 6, got %v", total)
+	}
+	if !reflect.DeepEqual(running, []int{0, 2, 9}) {
+		t.Errorf("Expected [0 2 9], got %v", running)
+	}
+}
+
+func TestMapAccumR(t *testing.T) {
+	total, running := MapAccumR(func(acc, x int) (int, int) { return acc + x, acc * x }, 0, []int{1, 2, 3})
+	if total != 6 {
+		t.Errorf("Expected 6, got %v", total)
+	}
+	if !reflect.DeepEqual(running, []int{5, 6, 0}) {
+		t.Errorf("Expected [5 6 0], got %v", running)
+	}
+}
+
+func TestIterate(t *testing.T) {
+	result := Collect(TakeSeq(Iterate(func(x int) int { return x * 2 }, 1), 5))
+	expected := []int{1, 2, 4, 8, 16}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestUnfoldr(t *testing.T) {
+	t.Run("finite", func(t *testing.T) {
+		countdown := Unfoldr(func(n int) (int, int, bool) { return n, n - 1, n > 0 }, 3)
+		result := Collect(countdown)
+		if !reflect.DeepEqual(result, []int{3, 2, 1}) {
+			t.Errorf("Expected [3 2 1], got %v", result)
+		}
+	})
+
+	t.Run("infinite fibonacci", func(t *testing.T) {
+		fibs := Unfoldr(func(s Tuple[int, int]) (int, Tuple[int, int], bool) {
+			return Fst(s), MkTuple(Snd(s), Fst(s)+Snd(s)), true
+		}, MkTuple(0, 1))
+		result := Collect(TakeWhileSeq(func(x int) bool { return x < 30 }, fibs))
+		expected := []int{0, 1, 1, 2, 3, 5, 8, 13, 21}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+}
+
+func TestRepeat(t *testing.T) {
+	result := Collect(TakeSeq(Repeat("x"), 3))
+	if !reflect.DeepEqual(result, []string{"x", "x", "x"}) {
+		t.Errorf("Expected [x x x], got %v", result)
+	}
+}
+
+func TestCycle(t *testing.T) {
+	result := Collect(TakeSeq(Cycle([]int{1, 2, 3}), 7))
+	expected := []int{1, 2, 3, 1, 2, 3, 1}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+	if result := Collect(TakeSeq(Cycle([]int{}), 3)); !reflect.DeepEqual(result, []int{}) {
+		t.Errorf("Expected [], got %v", result)
+	}
+}
+
+func TestTakeDropSeq(t *testing.T) {
+	tests := []struct {
+		name       string
+		num        int
+		take, drop []int
+	}{
+		{"inside range", 2, []int{1, 2}, []int{3, 4}},
+		{"zero", 0, []int{}, []int{1, 2, 3, 4}},
+		{"negative", -1, []int{}, []int{1, 2, 3, 4}},
+		{"beyond length", 9, []int{1, 2, 3, 4}, []int{}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			src := FromSlice([]int{1, 2, 3, 4})
+			if result := Collect(TakeSeq(src, tc.num)); !reflect.DeepEqual(result, tc.take) {
+				t.Errorf("TakeSeq: expected %v, got %v", tc.take, result)
+			}
+			if result := Collect(DropSeq(src, tc.num)); !reflect.DeepEqual(result, tc.drop) {
+				t.Errorf("DropSeq: expected %v, got %v", tc.drop, result)
+			}
+		})
+	}
+
+	t.Run("take does not pull past the limit", func(t *testing.T) {
+		pulled := 0
+		counting := MapSeq(func(x int) int { pulled++; return x }, naturals())
+		Collect(TakeSeq(counting, 3))
+		if pulled != 3 {
+			t.Errorf("Expected 3 elements to be pulled, got %d", pulled)
+		}
+	})
+}
+
+func TestDropWhileSeq(t *testing.T) {
+	result := Collect(TakeSeq(DropWhileSeq(func(x int) bool { return x < 5 }, naturals()), 3))
+	if !reflect.DeepEqual(result, []int{5, 6, 7}) {
+		t.Errorf("Expected [5 6 7], got %v", result)
+	}
+}
@@ -0,0 +1,118 @@
+package functionalgo
+
+// The search functions stop at the first match instead of filtering the whole
+// input. Functions returning an index use Option[int], None meaning no match.
+
+func Elem[A comparable](x A, src []A) bool {
+	for _, v := range src {
+		if v == x {
+			return true
+		}
+	}
+	return false
+}
+
+func NotElem[A comparable](x A, src []A) bool {
+	return !Elem(x, src)
+}
+
+func Find[A any](fn func(A) bool, src []A) Option[A] {
+	for _, v := range src {
+		if fn(v) {
+			return Some(v)
+		}
+	}
+	return None[A]()
+}
+
+// Lookup returns the value of the first pair in an association list whose
+// key equals key.
+func Lookup[K comparable, V any](key K, src []Tuple[K, V]) Option[V] {
+	for _, t := range src {
+		if t.fst == key {
+			return Some(t.snd)
+		}
+	}
+	return None[V]()
+}
+
+func FindIndex[A any](fn func(A) bool, src []A) Option[int] {
+	for i, v := range src {
+		if fn(v) {
+			return Some(i)
+		}
+	}
+	return None[int]()
+}
+
+func FindIndices[A any](fn func(A) bool, src []A) []int {
+	result := []int{}
+	for i, v := range src {
+		if fn(v) {
+			result = append(result, i)
+		}
+	}
+	return result
+}
+
+func ElemIndex[A comparable](x A, src []A) Option[int] {
+	return FindIndex(func(v A) bool { return v == x }, src)
+}
+
+func ElemIndices[A comparable](x A, src []A) []int {
+	return FindIndices(func(v A) bool { return v == x }, src)
+}
+
+func IsPrefixOf[A comparable](prefix, src []A) bool {
+	return hasPrefix(src, prefix)
+}
+
+func IsSuffixOf[A comparable](suffix, src []A) bool {
+	return len(suffix) <= len(src) && hasPrefix(src[len(src)-len(suffix):], suffix)
+}
+
+// IsInfixOf reports whether infix occurs as a contiguous run in src.
+func IsInfixOf[A comparable](infix, src []A) bool {
+	for i := 0; i+len(infix) <= len(src); i++ {
+		if hasPrefix(src[i:], infix) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSubsequenceOf reports whether the elements of sub occur in src in the
+// same order, not necessarily adjacent.
+func IsSubsequenceOf[A comparable](sub, src []A) bool {
+	i := 0
+	for _, v := range src {
+		if i == len(sub) {
+			break
+		}
+		if v == sub[i] {
+			i++
+		}
+	}
+	return i == len(sub)
+}
+
+// BinarySearch searches sorted, which must be in ascending Compare order. It
+// returns the position of the first element equal to target, or the position
+// where target would be inserted, and whether it was found.
+func BinarySearch[A any](target A, sorted []A) (int, bool) {
+	return BinarySearchBy(Compare[A], target, sorted)
+}
+
+// BinarySearchBy is BinarySearch for input sorted by ord.
+func BinarySearchBy[A any](ord Ordering[A], target A, sorted []A) (int, bool) {
+	lo, hi := 0, len(sorted)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if ord(sorted[mid], target) == LT {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo, lo < len(sorted) && ord(sorted[lo], target) == EQ
+}
@@ -0,0 +1,124 @@
+package functionalgo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestElem(t *testing.T) {
+	src := []string{"a", "b", "c"}
+	if !Elem("b", src) || Elem("z", src) {
+		t.Errorf("Expected Elem to report membership")
+	}
+	if !NotElem("z", src) || NotElem("a", src) {
+		t.Errorf("Expected NotElem to report non-membership")
+	}
+	if Elem(1, []int{}) {
+		t.Errorf("Expected no element in an empty slice")
+	}
+}
+
+func TestFindIndex(t *testing.T) {
+	src := []int{5, 8, 3, 8}
+	if result, ok := FindIndex(func(x int) bool { return x > 6 }, src).Get(); !ok || result != 1 {
+		t.Errorf("Expected Some(1), got %v", result)
+	}
+	if FindIndex(func(x int) bool { return x > 10 }, src).IsSome() {
+		t.Errorf("Expected None when nothing matches")
+	}
+	if result := FindIndices(func(x int) bool { return x > 4 }, src); !reflect.DeepEqual(result, []int{0, 1, 3}) {
+		t.Errorf("Expected [0 1 3], got %v", result)
+	}
+
+	t.Run("stops at the first match", func(t *testing.T) {
+		calls := 0
+		FindIndex(func(x int) bool { calls++; return x == 8 }, src)
+		if calls != 2 {
+			t.Errorf("Expected 2 calls, got %d", calls)
+		}
+	})
+}
+
+func TestElemIndex(t *testing.T) {
+	src := []rune("banana")
+	if result, ok := ElemIndex('n', src).Get(); !ok || result != 2 {
+		t.Errorf("Expected Some(2), got %v", result)
+	}
+	if ElemIndex('x', src).IsSome() {
+		t.Errorf("Expected None for missing element")
+	}
+	if result := ElemIndices('a', src); !reflect.DeepEqual(result, []int{1, 3, 5}) {
+		t.Errorf("Expected [1 3 5], got %v", result)
+	}
+	if result := ElemIndices('x', src); !reflect.DeepEqual(result, []int{}) {
+		t.Errorf("Expected [], got %v", result)
+	}
+}
+
+func TestSublistPredicates(t *testing.T) {
+	src := []int{1, 2, 3, 4}
+	tests := []struct {
+		name     string
+		fn       func(sub, src []int) bool
+		sub      []int
+		expected bool
+	}{
+		{"prefix", IsPrefixOf[int], []int{1, 2}, true},
+		{"not prefix", IsPrefixOf[int], []int{2, 3}, false},
+		{"empty prefix", IsPrefixOf[int], []int{}, true},
+		{"suffix", IsSuffixOf[int], []int{3, 4}, true},
+		{"not suffix", IsSuffixOf[int], []int{2, 3}, false},
+		{"suffix longer than source", IsSuffixOf[int], []int{0, 1, 2, 3, 4}, false},
+		{"infix", IsInfixOf[int], []int{2, 3}, true},
+		{"not infix", IsInfixOf[int], []int{1, 3}, false},
+		{"empty infix", IsInfixOf[int], []int{}, true},
+		{"subsequence", IsSubsequenceOf[int], []int{1, 3}, true},
+		{"out of order", IsSubsequenceOf[int], []int{3, 1}, false},
+		{"empty subsequence", IsSubsequenceOf[int], []int{}, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if result := tc.fn(tc.sub, src); result != tc.expected {
+				t.Errorf("Expected %v, got %v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestBinarySearch(t *testing.T) {
+	sorted := []int{1, 3, 3, 3, 7}
+	tests := []struct {
+		target int
+		index  int
+		found  bool
+	}{
+		{3, 1, true},
+		{7, 4, true},
+		{0, 0, false},
+		{4, 4, false},
+		{9, 5, false},
+	}
+	for _, tc := range tests {
+		index, found := BinarySearch(tc.target, sorted)
+		if index != tc.index || found != tc.found {
+			t.Errorf("Search %d: expected (%d, %v), got (%d, %v)", tc.target, tc.index, tc.found, index, found)
+		}
+	}
+
+	if index, found := BinarySearch(1, []int{}); index != 0 || found {
+		t.Errorf("Expected (0, false), got (%d, %v)", index, found)
+	}
+
+	t.Run("custom ordering", func(t *testing.T) {
+		byLength := Comparing(func(s string) int { return len(s) })
+		words := []string{"go", "elm", "rust", "scala"}
+		index, found := BinarySearchBy(byLength, "java", words)
+		if index != 2 || !found {
+			t.Errorf("Expected (2, true), got (%d, %v)", index, found)
+		}
+		index, found = BinarySearchBy(byLength.Reversed(), "c", []string{"scala", "elm", "go"})
+		if index != 3 || found {
+			t.Errorf("Expected (3, false), got (%d, %v)", index, found)
+		}
+	})
+}
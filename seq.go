@@ -0,0 +1,157 @@
+package functionalgo
+
+import "iter"
+
+// Seq and Seq2 are aliases of the standard iterator types, so lazy sequences
+// built here can be ranged over directly and passed to any iter-aware code.
+type (
+	Seq[A any]     = iter.Seq[A]
+	Seq2[A, B any] = iter.Seq2[A, B]
+)
+
+func FromSlice[A any](src []A) Seq[A] {
+	return func(yield func(A) bool) {
+		for _, v := range src {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func AppendSeq[A any](dst []A, seq Seq[A]) []A {
+	for v := range seq {
+		dst = append(dst, v)
+	}
+	return dst
+}
+
+func Collect[A any](seq Seq[A]) []A {
+	return AppendSeq([]A{}, seq)
+}
+
+func Enumerate[A any](seq Seq[A]) Seq2[int, A] {
+	return func(yield func(int, A) bool) {
+		i := 0
+		for v := range seq {
+			if !yield(i, v) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+func FromSeq2[A any, B any](seq Seq2[A, B]) Seq[Tuple[A, B]] {
+	return func(yield func(Tuple[A, B]) bool) {
+		for a, b := range seq {
+			if !yield(Tuple[A, B]{fst: a, snd: b}) {
+				return
+			}
+		}
+	}
+}
+
+func ToSeq2[A any, B any](seq Seq[Tuple[A, B]]) Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		for t := range seq {
+			if !yield(t.fst, t.snd) {
+				return
+			}
+		}
+	}
+}
+
+func MapSeq[A any, B any](fn func(A) B, seq Seq[A]) Seq[B] {
+	return func(yield func(B) bool) {
+		for v := range seq {
+			if !yield(fn(v)) {
+				return
+			}
+		}
+	}
+}
+
+func FilterSeq[A any](fn func(A) bool, seq Seq[A]) Seq[A] {
+	return func(yield func(A) bool) {
+		for v := range seq {
+			if fn(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func TakeWhileSeq[A any](fn func(A) bool, seq Seq[A]) Seq[A] {
+	return func(yield func(A) bool) {
+		for v := range seq {
+			if !fn(v) || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func ZipWithSeq[A any, B any, C any](fn func(A, B) C, seqA Seq[A], seqB Seq[B]) Seq[C] {
+	return func(yield func(C) bool) {
+		next, stop := iter.Pull(seqB)
+		defer stop()
+		for a := range seqA {
+			b, ok := next()
+			if !ok || !yield(fn(a, b)) {
+				return
+			}
+		}
+	}
+}
+
+func ZipSeq[A any, B any](seqA Seq[A], seqB Seq[B]) Seq[Tuple[A, B]] {
+	return ZipWithSeq(MkTuple[A, B], seqA, seqB)
+}
+
+func FoldlSeq[A any, B any](fn func(B, A) B, acc B, seq Seq[A]) B {
+	for v := range seq {
+		acc = fn(acc, v)
+	}
+	return acc
+}
+
+// ScanSeq yields the initial accumulator followed by every intermediate
+// result of folding seq from the left, like Haskell's scanl.
+func ScanSeq[A any, B any](fn func(B, A) B, acc B, seq Seq[A]) Seq[B] {
+	return func(yield func(B) bool) {
+		acc := acc
+		if !yield(acc) {
+			return
+		}
+		for v := range seq {
+			acc = fn(acc, v)
+			if !yield(acc) {
+				return
+			}
+		}
+	}
+}
+
+// ChunkSeq groups seq into slices of size elements; the last chunk may be
+// shorter. Every chunk is a fresh slice that is safe to retain.
+func ChunkSeq[A any](size int, seq Seq[A]) Seq[[]A] {
+	if size <= 0 {
+		panic("chunk size must be positive")
+	}
+	return func(yield func([]A) bool) {
+		chunk := make([]A, 0, size)
+		for v := range seq {
+			chunk = append(chunk, v)
+			if len(chunk) == size {
+				if !yield(chunk) {
+					return
+				}
+				chunk = make([]A, 0, size)
+			}
+		}
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package functionalgo
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func naturals() Seq[int] {
+	return func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func TestFromSliceAndCollect(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		result := Collect(FromSlice([]int{1, 2, 3}))
+		expected := []int{1, 2, 3}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("collect empty sequence", func(t *testing.T) {
+		result := Collect(FromSlice([]int{}))
+		expected := []int{}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("range over func", func(t *testing.T) {
+		var result []string
+		for v := range FromSlice([]string{"a", "b"}) {
+			result = append(result, v)
+		}
+		expected := []string{"a", "b"}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("interoperates with slices package", func(t *testing.T) {
+		result := slices.Collect(MapSeq(func(x int) int { return x * 10 }, slices.Values([]int{1, 2})))
+		expected := []int{10, 20}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+}
+
+func TestEnumerate(t *testing.T) {
+	var indices []int
+	var values []string
+	for i, v := range Enumerate(FromSlice([]string{"x", "y", "z"})) {
+		indices = append(indices, i)
+		values = append(values, v)
+	}
+	if !reflect.DeepEqual(indices, []int{0, 1, 2}) {
+		t.Errorf("Expected %v, got %v", []int{0, 1, 2}, indices)
+	}
+	if !reflect.DeepEqual(values, []string{"x", "y", "z"}) {
+		t.Errorf("Expected %v, got %v", []string{"x", "y", "z"}, values)
+	}
+}
+
+func TestSeq2Conversion(t *testing.T) {
+	tuples := Collect(FromSeq2(slices.All([]string{"a", "b"})))
+	expected := []Tuple[int, string]{{fst: 0, snd: "a"}, {fst: 1, snd: "b"}}
+	if !reflect.DeepEqual(tuples, expected) {
+		t.Errorf("Expected %v, got %v", expected, tuples)
+	}
+
+	var keys []int
+	for k := range ToSeq2(FromSlice(tuples)) {
+		keys = append(keys, k)
+	}
+	if !reflect.DeepEqual(keys, []int{0, 1}) {
+		t.Errorf("Expected %v, got %v", []int{0, 1}, keys)
+	}
+}
+
+func TestMapSeq(t *testing.T) {
+	t.Run("map is lazy", func(t *testing.T) {
+		calls := 0
+		seq := MapSeq(func(x int) int { calls++; return x * 2 }, naturals())
+		if calls != 0 {
+			t.Errorf("Expected no calls before iteration, got %d", calls)
+		}
+		result := Collect(TakeWhileSeq(func(x int) bool { return x < 6 }, seq))
+		expected := []int{0, 2, 4}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+		if calls != 4 {
+			t.Errorf("Expected 4 calls, got %d", calls)
+		}
+	})
+}
+
+func TestFilterSeq(t *testing.T) {
+	evens := FilterSeq(func(x int) bool { return x%2 == 0 }, naturals())
+	result := Collect(TakeWhileSeq(func(x int) bool { return x < 10 }, evens))
+	expected := []int{0, 2, 4, 6, 8}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestTakeWhileSeq(t *testing.T) {
+	t.Run("stops at first failing element", func(t *testing.T) {
+		result := Collect(TakeWhileSeq(func(x int) bool { return x < 3 }, FromSlice([]int{1, 2, 3, 1})))
+		expected := []int{1, 2}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("early break from range", func(t *testing.T) {
+		count := 0
+		for range TakeWhileSeq(func(int) bool { return true }, naturals()) {
+			count++
+			if count == 5 {
+				break
+			}
+		}
+		if count != 5 {
+			t.Errorf("Expected 5, got %d", count)
+		}
+	})
+}
+
+func TestZipSeq(t *testing.T) {
+	t.Run("zip finite with infinite", func(t *testing.T) {
+		result := Collect(ZipSeq(FromSlice([]string{"a", "b", "c"}), naturals()))
+		expected := []Tuple[string, int]{{fst: "a", snd: 0}, {fst: "b", snd: 1}, {fst: "c", snd: 2}}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("zip with shorter second sequence", func(t *testing.T) {
+		result := Collect(ZipWithSeq(func(a, b int) int { return a + b }, naturals(), FromSlice([]int{10, 20})))
+		expected := []int{10, 21}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+}
+
+func TestFoldlSeq(t *testing.T) {
+	result := FoldlSeq(func(acc string, x string) string { return acc + x }, ">", FromSlice([]string{"a", "b", "c"}))
+	expected := ">abc"
+	if result != expected {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestScanSeq(t *testing.T) {
+	t.Run("running totals", func(t *testing.T) {
+		result := Collect(ScanSeq(func(acc, x int) int { return acc + x }, 0, FromSlice([]int{1, 2, 3})))
+		expected := []int{0, 1, 3, 6}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("sequence can be iterated twice", func(t *testing.T) {
+		seq := ScanSeq(func(acc, x int) int { return acc + x }, 0, FromSlice([]int{1, 2}))
+		first := Collect(seq)
+		second := Collect(seq)
+		if !reflect.DeepEqual(first, second) {
+			t.Errorf("Expected %v, got %v", first, second)
+		}
+	})
+}
+
+func TestChunkSeq(t *testing.T) {
+	t.Run("uneven chunks", func(t *testing.T) {
+		result := Collect(ChunkSeq(2, FromSlice([]int{1, 2, 3, 4, 5})))
+		expected := [][]int{{1, 2}, {3, 4}, {5}}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("chunks of infinite sequence", func(t *testing.T) {
+		next := 0
+		for chunk := range ChunkSeq(3, naturals()) {
+			expected := []int{next, next + 1, next + 2}
+			if !reflect.DeepEqual(chunk, expected) {
+				t.Errorf("Expected %v, got %v", expected, chunk)
+			}
+			next += 3
+			if next > 9 {
+				break
+			}
+		}
+	})
+
+	t.Run("non-positive size panics", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("Expected ChunkSeq with size 0 to panic")
+			}
+		}()
+		ChunkSeq(0, naturals())
+	})
+}
@@ -0,0 +1,167 @@
+package functionalgo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Sequence is a persistent sequence backed by a FingerTree measured by size.
+// Pushes and pops at either end are amortised O(1), and Index, Update,
+// Insert, Delete, SplitAt and Concat are O(log n). The zero value is an
+// empty sequence.
+type Sequence[T any] struct {
+	t FingerTree[T, int]
+}
+
+func sizeMeasure[T any]() Measure[T, int] {
+	return Measure[T, int]{
+		Combine: func(a, b int) int { return a + b },
+		Of:      func(T) int { return 1 },
+	}
+}
+
+func SequenceOf[T any](xs ...T) Sequence[T] {
+	return SequenceFromSlice(xs)
+}
+
+func SequenceFromSlice[T any](src []T) Sequence[T] {
+	return Sequence[T]{FingerTreeFromSlice(sizeMeasure[T](), src)}
+}
+
+func SequenceFromSeq[T any](seq Seq[T]) Sequence[T] {
+	return SequenceFromSlice(Collect(seq))
+}
+
+func (s Sequence[T]) tree() FingerTree[T, int] {
+	if s.t.measure == nil {
+		return FingerTreeWith(sizeMeasure[T]())
+	}
+	return s.t
+}
+
+func (s Sequence[T]) Len() int {
+	if s.t.root == nil {
+		return 0
+	}
+	return s.t.Measure()
+}
+
+func (s Sequence[T]) IsEmpty() bool {
+	return s.t.root == nil
+}
+
+func (s Sequence[T]) PushFront(x T) Sequence[T] {
+	return Sequence[T]{s.tree().PushFront(x)}
+}
+
+func (s Sequence[T]) PushBack(x T) Sequence[T] {
+	return Sequence[T]{s.tree().PushBack(x)}
+}
+
+func (s Sequence[T]) PeekFront() Option[T] {
+	return s.t.PeekFront()
+}
+
+func (s Sequence[T]) PeekBack() Option[T] {
+	return s.t.PeekBack()
+}
+
+func (s Sequence[T]) PopFront() (Option[T], Sequence[T]) {
+	x, t := s.t.PopFront()
+	return x, Sequence[T]{t}
+}
+
+func (s Sequence[T]) PopBack() (Option[T], Sequence[T]) {
+	x, t := s.t.PopBack()
+	return x, Sequence[T]{t}
+}
+
+func (s Sequence[T]) Index(i int) T {
+	s.checkIndex(i)
+	return s.t.Lookup(func(n int) bool { return n > i }).Unwrap()
+}
+
+// Update returns a copy of s with the element at i replaced by x.
+func (s Sequence[T]) Update(i int, x T) Sequence[T] {
+	s.checkIndex(i)
+	l, r := s.SplitAt(i)
+	_, r = r.PopFront()
+	return l.PushBack(x).Concat(r)
+}
+
+// Insert returns a copy of s with x inserted before index i; i may equal
+// Len to insert at the end.
+func (s Sequence[T]) Insert(i int, x T) Sequence[T] {
+	if i < 0 || i > s.Len() {
+		panic(fmt.Sprintf("index %d out of range for insertion into sequence of length %d", i, s.Len()))
+	}
+	l, r := s.SplitAt(i)
+	return l.PushBack(x).Concat(r)
+}
+
+// Delete returns a copy of s without the element at i.
+func (s Sequence[T]) Delete(i int) Sequence[T] {
+	s.checkIndex(i)
+	l, r := s.SplitAt(i)
+	_, r = r.PopFront()
+	return l.Concat(r)
+}
+
+// SplitAt returns the first i elements and the rest. Like Take and Drop, it
+// clamps i to the bounds of s.
+func (s Sequence[T]) SplitAt(i int) (Sequence[T], Sequence[T]) {
+	l, r := s.tree().Split(func(n int) bool { return n > i })
+	return Sequence[T]{l}, Sequence[T]{r}
+}
+
+func (s Sequence[T]) Concat(other Sequence[T]) Sequence[T] {
+	return Sequence[T]{s.t.Concat(other.t)}
+}
+
+func (s Sequence[T]) Seq() Seq[T] {
+	return s.t.Seq()
+}
+
+func (s Sequence[T]) Descending() Seq[T] {
+	return s.t.Descending()
+}
+
+func (s Sequence[T]) ToSlice() []T {
+	return AppendSeq(make([]T, 0, s.Len()), s.Seq())
+}
+
+func (s Sequence[T]) String() string {
+	var sb strings.Builder
+	sb.WriteByte('[')
+	for i, x := range Enumerate(s.Seq()) {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		fmt.Fprint(&sb, x)
+	}
+	sb.WriteByte(']')
+	return sb.String()
+}
+
+func (s Sequence[T]) checkIndex(i int) {
+	if n := s.Len(); i < 0 || i >= n {
+		panic(fmt.Sprintf("index %d out of range for sequence of length %d", i, n))
+	}
+}
+
+func MapSequence[A any, B any](fn func(A) B, s Sequence[A]) Sequence[B] {
+	return SequenceFromSeq(MapSeq(fn, s.Seq()))
+}
+
+func FoldlSequence[A any, B any](fn func(B, A) B, acc B, s Sequence[A]) B {
+	return FoldlSeq(fn, acc, s.Seq())
+}
+
+// FoldrSequence folds from the right, visiting elements back to front
+// without copying them out first.
+func FoldrSequence[A any, B any](fn func(A, B) B, acc B, s Sequence[A]) B {
+	for x := range s.Descending() {
+		acc = fn(x, acc)
+	}
+	return acc
+}
@@ -0,0 +1,133 @@
+package functionalgo
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestSequenceBasics(t *testing.T) {
+	var empty Sequence[int]
+	if !empty.IsEmpty() || empty.Len() != 0 || empty.String() != "[]" || empty.PeekFront().IsSome() {
+		t.Errorf("Expected the zero value to be an empty sequence")
+	}
+	if x, _ := empty.PopBack(); x.IsSome() {
+		t.Errorf("Expected popping an empty sequence to return None")
+	}
+
+	s := SequenceOf(2, 3).PushFront(1).PushBack(4)
+	if s.String() != "[1, 2, 3, 4]" || s.Len() != 4 {
+		t.Errorf("Expected [1, 2, 3, 4], got %s", s)
+	}
+	if s.Index(2) != 3 || s.PeekFront() != Some(1) || s.PeekBack() != Some(4) {
+		t.Errorf("Expected element 2 to be 3 and ends 1 and 4")
+	}
+	if result := s.Update(1, 20).ToSlice(); !reflect.DeepEqual(result, []int{1, 20, 3, 4}) {
+		t.Errorf("Expected [1 20 3 4], got %v", result)
+	}
+	if result := s.Insert(4, 5).Insert(0, 0).ToSlice(); !reflect.DeepEqual(result, []int{0, 1, 2, 3, 4, 5}) {
+		t.Errorf("Expected [0 1 2 3 4 5], got %v", result)
+	}
+	if result := s.Delete(0).ToSlice(); !reflect.DeepEqual(result, []int{2, 3, 4}) {
+		t.Errorf("Expected [2 3 4], got %v", result)
+	}
+	if s.Len() != 4 || s.Index(1) != 2 {
+		t.Errorf("Expected updates to leave the original intact, got %s", s)
+	}
+
+	t.Run("split at clamps", func(t *testing.T) {
+		for _, tc := range []struct {
+			i           int
+			left, right []int
+		}{
+			{-1, []int{}, []int{1, 2, 3, 4}},
+			{0, []int{}, []int{1, 2, 3, 4}},
+			{2, []int{1, 2}, []int{3, 4}},
+			{9, []int{1, 2, 3, 4}, []int{}},
+		} {
+			l, r := s.SplitAt(tc.i)
+			if !reflect.DeepEqual(l.ToSlice(), tc.left) || !reflect.DeepEqual(r.ToSlice(), tc.right) {
+				t.Errorf("SplitAt(%d): expected %v and %v, got %v and %v", tc.i, tc.left, tc.right, l.ToSlice(), r.ToSlice())
+			}
+		}
+	})
+
+	t.Run("out of range panics", func(t *testing.T) {
+		expectPanic(t, "Index", func() { s.Index(4) })
+		expectPanic(t, "Update", func() { s.Update(-1, 0) })
+		expectPanic(t, "Insert", func() { s.Insert(5, 0) })
+		expectPanic(t, "Delete", func() { empty.Delete(0) })
+	})
+}
+
+func TestSequenceCombinators(t *testing.T) {
+	s := SequenceOf(1, 2, 3)
+	if result := MapSequence(func(x int) int { return x * x }, s).ToSlice(); !reflect.DeepEqual(result, []int{1, 4, 9}) {
+		t.Errorf("Expected [1 4 9], got %v", result)
+	}
+	if result := FoldlSequence(func(acc, x int) int { return acc - x }, 0, s); result != Foldl(func(acc, x int) int { return acc - x }, 0, []int{1, 2, 3}) {
+		t.Errorf("Expected FoldlSequence to agree with Foldl, got %d", result)
+	}
+	if result := FoldrSequence(func(x, acc int) int { return x - acc }, 0, s); result != Foldr(func(x, acc int) int { return x - acc }, 0, []int{1, 2, 3}) {
+		t.Errorf("Expected FoldrSequence to agree with Foldr, got %d", result)
+	}
+	if result := Collect(s.Concat(SequenceOf(4)).Descending()); !reflect.DeepEqual(result, []int{4, 3, 2, 1}) {
+		t.Errorf("Expected [4 3 2 1], got %v", result)
+	}
+}
+
+func TestSequenceRandomOps(t *testing.T) {
+	rng := rand.New(rand.NewPCG(9, 10))
+	var s Sequence[int]
+	var model []int
+	for step := range 3000 {
+		switch op := rng.IntN(10); {
+		case op < 2:
+			s, model = s.PushFront(step), slices.Concat([]int{step}, model)
+		case op < 4:
+			s, model = s.PushBack(step), slices.Concat(model, []int{step})
+		case op < 5 && len(model) > 0:
+			_, s = s.PopFront()
+			model = model[1:]
+		case op < 6 && len(model) > 0:
+			_, s = s.PopBack()
+			model = model[:len(model)-1]
+		case op < 7 && len(model) > 0:
+			i := rng.IntN(len(model))
+			s = s.Update(i, -step)
+			model = slices.Clone(model)
+			model[i] = -step
+		case op < 8:
+			i := rng.IntN(len(model) + 1)
+			s, model = s.Insert(i, step), slices.Insert(slices.Clone(model), i, step)
+		case op < 9 && len(model) > 0:
+			i := rng.IntN(len(model))
+			s, model = s.Delete(i), slices.Delete(slices.Clone(model), i, i+1)
+		default:
+			n := rng.IntN(100)
+			other := SequenceFromSlice(Range(0, n, 1))
+			if rng.IntN(2) == 0 {
+				s, model = s.Concat(other), slices.Concat(model, Range(0, n, 1))
+			} else {
+				s, model = other.Concat(s), slices.Concat(Range(0, n, 1), model)
+			}
+		}
+		if step%50 == 0 {
+			checkFingerTree(t, s.t)
+		}
+		if s.Len() != len(model) {
+			t.Fatalf("Step %d: expected length %d, got %d", step, len(model), s.Len())
+		}
+		if len(model) > 0 {
+			i := rng.IntN(len(model))
+			if s.Index(i) != model[i] {
+				t.Fatalf("Step %d: expected element %d to be %d, got %d", step, i, model[i], s.Index(i))
+			}
+		}
+	}
+	checkFingerTree(t, s.t)
+	if !slices.Equal(s.ToSlice(), model) {
+		t.Errorf("Expected the sequence to match the slice model")
+	}
+}
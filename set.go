@@ -0,0 +1,126 @@
+package functionalgo
+
+// The set operations follow Haskell's Data.List: results keep the order of
+// their inputs, and only Nub and Union remove duplicates. The On variants hash
+// a comparable key and run in linear time; the By variants accept any
+// equality and are quadratic.
+
+func Nub[A comparable](src []A) []A {
+	return NubOn(identity[A], src)
+}
+
+// NubOn keeps the first element for every key.
+func NubOn[A any, K comparable](key func(A) K, src []A) []A {
+	seen := map[K]bool{}
+	return Filter(func(v A) bool {
+		k := key(v)
+		if seen[k] {
+			return false
+		}
+		seen[k] = true
+		return true
+	}, src)
+}
+
+func NubBy[A any](eq func(A, A) bool, src []A) []A {
+	result := []A{}
+	for _, v := range src {
+		if !Any(func(kept A) bool { return eq(kept, v) }, result) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// Union returns xs followed by the elements of ys that are not in xs, without
+// their duplicates. Duplicates already in xs are kept.
+func Union[A comparable](xs, ys []A) []A {
+	return UnionOn(identity[A], xs, ys)
+}
+
+func UnionOn[A any, K comparable](key func(A) K, xs, ys []A) []A {
+	seen := map[K]bool{}
+	for _, x := range xs {
+		seen[key(x)] = true
+	}
+	return append(append([]A{}, xs...), NubOn(key, Filter(func(y A) bool { return !seen[key(y)] }, ys))...)
+}
+
+func UnionBy[A any](eq func(A, A) bool, xs, ys []A) []A {
+	extra := Filter(func(y A) bool {
+		return !Any(func(x A) bool { return eq(x, y) }, xs)
+	}, NubBy(eq, ys))
+	return append(append([]A{}, xs...), extra...)
+}
+
+// Intersect returns the elements of xs that also occur in ys, including
+// duplicates from xs.
+func Intersect[A comparable](xs, ys []A) []A {
+	return IntersectOn(identity[A], xs, ys)
+}
+
+func IntersectOn[A any, K comparable](key func(A) K, xs, ys []A) []A {
+	keys := map[K]bool{}
+	for _, y := range ys {
+		keys[key(y)] = true
+	}
+	return Filter(func(x A) bool { return keys[key(x)] }, xs)
+}
+
+func IntersectBy[A any](eq func(A, A) bool, xs, ys []A) []A {
+	return Filter(func(x A) bool {
+		return Any(func(y A) bool { return eq(x, y) }, ys)
+	}, xs)
+}
+
+// Difference removes from xs the first occurrence of each element of ys, like
+// Haskell's (\\): Difference([1 1 2], [1]) is [1 2].
+func Difference[A comparable](xs, ys []A) []A {
+	return DifferenceOn(identity[A], xs, ys)
+}
+
+func DifferenceOn[A any, K comparable](key func(A) K, xs, ys []A) []A {
+	counts := map[K]int{}
+	for _, y := range ys {
+		counts[key(y)]++
+	}
+	return Filter(func(x A) bool {
+		k := key(x)
+		if counts[k] > 0 {
+			counts[k]--
+			return false
+		}
+		return true
+	}, xs)
+}
+
+func DifferenceBy[A any](eq func(A, A) bool, xs, ys []A) []A {
+	remaining := append([]A{}, ys...)
+	return Filter(func(x A) bool {
+		for i, y := range remaining {
+			if eq(x, y) {
+				remaining = append(remaining[:i], remaining[i+1:]...)
+				return false
+			}
+		}
+		return true
+	}, xs)
+}
+
+// SymmetricDifference returns Difference(xs, ys) followed by
+// Difference(ys, xs).
+func SymmetricDifference[A comparable](xs, ys []A) []A {
+	return SymmetricDifferenceOn(identity[A], xs, ys)
+}
+
+func SymmetricDifferenceOn[A any, K comparable](key func(A) K, xs, ys []A) []A {
+	return append(DifferenceOn(key, xs, ys), DifferenceOn(key, ys, xs)...)
+}
+
+func SymmetricDifferenceBy[A any](eq func(A, A) bool, xs, ys []A) []A {
+	return append(DifferenceBy(eq, xs, ys), DifferenceBy(func(a, b A) bool { return eq(b, a) }, ys, xs)...)
+}
+
+func identity[A any](x A) A {
+	return x
+}
@@ -0,0 +1,97 @@
+package functionalgo
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNub(t *testing.T) {
+	if result := Nub([]int{3, 1, 3, 2, 1}); !reflect.DeepEqual(result, []int{3, 1, 2}) {
+		t.Errorf("Expected [3 1 2], got %v", result)
+	}
+	if result := Nub([]int{}); !reflect.DeepEqual(result, []int{}) {
+		t.Errorf("Expected [], got %v", result)
+	}
+
+	t.Run("on key keeps first", func(t *testing.T) {
+		result := NubOn(strings.ToLower, []string{"Go", "rust", "GO", "Rust", "elm"})
+		expected := []string{"Go", "rust", "elm"}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("by equality", func(t *testing.T) {
+		close := func(a, b float64) bool { return a-b < 0.5 && b-a < 0.5 }
+		result := NubBy(close, []float64{1, 1.2, 2, 1.9, 3})
+		if !reflect.DeepEqual(result, []float64{1, 2, 3}) {
+			t.Errorf("Expected [1 2 3], got %v", result)
+		}
+	})
+}
+
+func TestSetOperations(t *testing.T) {
+	xs, ys := []int{1, 2, 2, 3, 4}, []int{4, 5, 5, 2, 6}
+	eq := func(a, b int) bool { return a == b }
+	tests := []struct {
+		name     string
+		fn       func(xs, ys []int) []int
+		by       func(eq func(int, int) bool, xs, ys []int) []int
+		expected []int
+	}{
+		{"union", Union[int], UnionBy[int], []int{1, 2, 2, 3, 4, 5, 6}},
+		{"intersect", Intersect[int], IntersectBy[int], []int{2, 2, 4}},
+		{"difference", Difference[int], DifferenceBy[int], []int{1, 2, 3}},
+		{"symmetric difference", SymmetricDifference[int], SymmetricDifferenceBy[int], []int{1, 2, 3, 5, 5, 6}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if result := tc.fn(xs, ys); !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, result)
+			}
+			if result := tc.by(eq, xs, ys); !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("By variant: expected %v, got %v", tc.expected, result)
+			}
+		})
+	}
+
+	t.Run("inputs are not modified", func(t *testing.T) {
+		if !reflect.DeepEqual(xs, []int{1, 2, 2, 3, 4}) || !reflect.DeepEqual(ys, []int{4, 5, 5, 2, 6}) {
+			t.Errorf("Expected inputs to be unchanged, got %v %v", xs, ys)
+		}
+	})
+
+	t.Run("empty inputs", func(t *testing.T) {
+		if result := Union([]int{}, []int{}); !reflect.DeepEqual(result, []int{}) {
+			t.Errorf("Expected [], got %v", result)
+		}
+		if result := Difference([]int{1}, []int{}); !reflect.DeepEqual(result, []int{1}) {
+			t.Errorf("Expected [1], got %v", result)
+		}
+	})
+}
+
+func TestSetOperationsOnKey(t *testing.T) {
+	type record struct {
+		id   int
+		name string
+	}
+	ours := []record{{1, "ann"}, {2, "bob"}, {3, "cy"}}
+	theirs := []record{{2, "bobby"}, {4, "dee"}}
+	id := func(r record) int { return r.id }
+	names := func(rs []record) []string { return Map(func(r record) string { return r.name }, rs) }
+
+	if result := names(UnionOn(id, ours, theirs)); !reflect.DeepEqual(result, []string{"ann", "bob", "cy", "dee"}) {
+		t.Errorf("UnionOn: got %v", result)
+	}
+	if result := names(IntersectOn(id, ours, theirs)); !reflect.DeepEqual(result, []string{"bob"}) {
+		t.Errorf("IntersectOn: got %v", result)
+	}
+	if result := names(DifferenceOn(id, ours, theirs)); !reflect.DeepEqual(result, []string{"ann", "cy"}) {
+		t.Errorf("DifferenceOn: got %v", result)
+	}
+	if result := names(SymmetricDifferenceOn(id, ours, theirs)); !reflect.DeepEqual(result, []string{"ann", "cy", "dee"}) {
+		t.Errorf("SymmetricDifferenceOn: got %v", result)
+	}
+}
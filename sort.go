@@ -0,0 +1,140 @@
+package functionalgo
+
+// The sorting functions never modify their input and are stable: elements
+// that compare EQ keep their original relative order.
+
+// Sort returns a sorted copy of src, ordered by Compare.
+func Sort[A any](src []A) []A {
+	return SortBy(Compare[A], src)
+}
+
+// SortOrd is the compile-time checked counterpart of Sort.
+func SortOrd[A Ord](src []A) []A {
+	return SortBy(CompareOrd[A], src)
+}
+
+// SortBy returns a copy of src sorted by ord using a bottom-up merge sort.
+func SortBy[A any](ord Ordering[A], src []A) []A {
+	result := make([]A, len(src))
+	copy(result, src)
+	mergeSort(ord, result)
+	return result
+}
+
+// SortOn sorts src by key, computing the key only once per element.
+func SortOn[A any, K any](key func(A) K, src []A) []A {
+	type keyed struct {
+		key K
+		val A
+	}
+	decorated := make([]keyed, len(src))
+	for i, v := range src {
+		decorated[i] = keyed{key(v), v}
+	}
+	mergeSort(func(a, b keyed) ComparisonResult { return Compare(a.key, b.key) }, decorated)
+	return Map(func(k keyed) A { return k.val }, decorated)
+}
+
+func Insert[A any](x A, sorted []A) []A {
+	return InsertBy(Compare[A], x, sorted)
+}
+
+// InsertBy returns a copy of sorted with x inserted before the first element
+// greater than it.
+func InsertBy[A any](ord Ordering[A], x A, sorted []A) []A {
+	i := 0
+	for i < len(sorted) && ord(sorted[i], x) != GT {
+		i++
+	}
+	result := make([]A, 0, len(sorted)+1)
+	result = append(result, sorted[:i]...)
+	result = append(result, x)
+	return append(result, sorted[i:]...)
+}
+
+func MergeSorted[A any](srcs ...[]A) []A {
+	return MergeSortedBy(Compare[A], srcs...)
+}
+
+// MergeSortedBy merges slices that are each sorted by ord into one sorted
+// slice. Equal elements from earlier slices come first.
+func MergeSortedBy[A any](ord Ordering[A], srcs ...[]A) []A {
+	switch len(srcs) {
+	case 0:
+		return []A{}
+	case 1:
+		return append([]A{}, srcs[0]...)
+	}
+	mid := len(srcs) / 2
+	left, right := MergeSortedBy(ord, srcs[:mid]...), MergeSortedBy(ord, srcs[mid:]...)
+	result := make([]A, len(left)+len(right))
+	merge(ord, result, left, right)
+	return result
+}
+
+func IsSorted[A any](src []A) bool {
+	return IsSortedBy(Compare[A], src)
+}
+
+func IsSortedOrd[A Ord](src []A) bool {
+	return IsSortedBy(CompareOrd[A], src)
+}
+
+func IsSortedBy[A any](ord Ordering[A], src []A) bool {
+	for i := 1; i < len(src); i++ {
+		if ord(src[i-1], src[i]) == GT {
+			return false
+		}
+	}
+	return true
+}
+
+const insertionSortRun = 12
+
+// mergeSort sorts runs of insertionSortRun elements by insertion and then
+// merges runs of doubling width, alternating between src and a buffer.
+func mergeSort[A any](ord Ordering[A], src []A) {
+	n := len(src)
+	for start := 0; start < n; start += insertionSortRun {
+		insertionSort(ord, src[start:min(start+insertionSortRun, n)])
+	}
+	if n <= insertionSortRun {
+		return
+	}
+	from, to := src, make([]A, n)
+	for width := insertionSortRun; width < n; width *= 2 {
+		for lo := 0; lo < n; lo += 2 * width {
+			mid, hi := min(lo+width, n), min(lo+2*width, n)
+			merge(ord, to[lo:hi], from[lo:mid], from[mid:hi])
+		}
+		from, to = to, from
+	}
+	if &from[0] != &src[0] {
+		copy(src, from)
+	}
+}
+
+func insertionSort[A any](ord Ordering[A], src []A) {
+	for i := 1; i < len(src); i++ {
+		for j := i; j > 0 && ord(src[j-1], src[j]) == GT; j-- {
+			src[j-1], src[j] = src[j], src[j-1]
+		}
+	}
+}
+
+// merge writes the merge of left and right into dst, taking from left on ties.
+func merge[A any](ord Ordering[A], dst, left, right []A) {
+	i, j, k := 0, 0, 0
+	for i < len(left) && j < len(right) {
+		if ord(right[j], left[i]) == LT {
+			dst[k] = right[j]
+			j++
+		} else {
+			dst[k] = left[i]
+			i++
+		}
+		k++
+	}
+	k += copy(dst[k:], left[i:])
+	copy(dst[k:], right[j:])
+}
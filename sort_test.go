@@ -0,0 +1,146 @@
+package functionalgo
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSort(t *testing.T) {
+	t.Run("does not modify input", func(t *testing.T) {
+		src := []int{3, 1, 2}
+		result := Sort(src)
+		if !reflect.DeepEqual(result, []int{1, 2, 3}) {
+			t.Errorf("Expected [1 2 3], got %v", result)
+		}
+		if !reflect.DeepEqual(src, []int{3, 1, 2}) {
+			t.Errorf("Expected input to be unchanged, got %v", src)
+		}
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		if result := Sort([]string{}); !reflect.DeepEqual(result, []string{}) {
+			t.Errorf("Expected [], got %v", result)
+		}
+	})
+
+	t.Run("structs and tuples", func(t *testing.T) {
+		result := Sort([]Tuple[string, int]{MkTuple("b", 1), MkTuple("a", 2), MkTuple("a", 1)})
+		expected := []Tuple[string, int]{MkTuple("a", 1), MkTuple("a", 2), MkTuple("b", 1)}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+}
+
+func TestSortOrd(t *testing.T) {
+	src := []priority{3, 1, 2}
+	if result := SortOrd(src); !reflect.DeepEqual(result, []priority{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", result)
+	}
+	if !reflect.DeepEqual(src, []priority{3, 1, 2}) {
+		t.Errorf("Expected input to be unchanged, got %v", src)
+	}
+	if !IsSortedOrd([]string{"a", "b", "b"}) || IsSortedOrd([]float64{2, 1}) {
+		t.Errorf("Expected IsSortedOrd to detect order")
+	}
+}
+
+func TestSortByStability(t *testing.T) {
+	byKey := Comparing(func(t Tuple[int, int]) int { return Fst(t) })
+	for _, n := range []int{0, 1, 11, 12, 13, 100, 1000} {
+		src := make([]Tuple[int, int], n)
+		for i := range src {
+			src[i] = MkTuple(rand.IntN(10), i)
+		}
+		expected := slices.Clone(src)
+		slices.SortStableFunc(expected, func(a, b Tuple[int, int]) int { return Fst(a) - Fst(b) })
+		if result := SortBy(byKey, src); !reflect.DeepEqual(result, expected) {
+			t.Errorf("Size %d: expected a stable sort, got %v", n, result)
+		}
+	}
+}
+
+func TestSortOn(t *testing.T) {
+	calls := 0
+	key := func(s string) string { calls++; return strings.ToLower(s) }
+	src := []string{"banana", "Apple", "cherry", "apple"}
+	result := SortOn(key, src)
+	expected := []string{"Apple", "apple", "banana", "cherry"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+	if calls != len(src) {
+		t.Errorf("Expected key to be computed %d times, got %d", len(src), calls)
+	}
+}
+
+func TestInsertBy(t *testing.T) {
+	tests := []struct {
+		name     string
+		x        int
+		expected []int
+	}{
+		{"front", 0, []int{0, 1, 3, 5}},
+		{"middle", 4, []int{1, 3, 4, 5}},
+		{"end", 9, []int{1, 3, 5, 9}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sorted := []int{1, 3, 5}
+			if result := Insert(tc.x, sorted); !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, result)
+			}
+			if !reflect.DeepEqual(sorted, []int{1, 3, 5}) {
+				t.Errorf("Expected input to be unchanged, got %v", sorted)
+			}
+		})
+	}
+
+	t.Run("after equal elements", func(t *testing.T) {
+		byLen := Comparing(func(s string) int { return len(s) })
+		result := InsertBy(byLen, "ml", []string{"c", "go", "js", "elm"})
+		expected := []string{"c", "go", "js", "ml", "elm"}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, got %v", expected, result)
+		}
+	})
+}
+
+func TestMergeSorted(t *testing.T) {
+	result := MergeSorted([]int{1, 4, 7}, []int{2, 5}, []int{}, []int{3, 6, 8, 9})
+	expected := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+	if result := MergeSorted[int](); !reflect.DeepEqual(result, []int{}) {
+		t.Errorf("Expected [], got %v", result)
+	}
+
+	t.Run("ties keep input order", func(t *testing.T) {
+		byKey := Comparing(func(t Tuple[int, string]) int { return Fst(t) })
+		result := MergeSortedBy(byKey,
+			[]Tuple[int, string]{MkTuple(1, "a"), MkTuple(2, "a")},
+			[]Tuple[int, string]{MkTuple(1, "b")},
+			[]Tuple[int, string]{MkTuple(1, "c"), MkTuple(2, "c")},
+		)
+		expected := []string{"a", "b", "c", "a", "c"}
+		if labels := Map(Snd[int, string], result); !reflect.DeepEqual(labels, expected) {
+			t.Errorf("Expected %v, got %v", expected, labels)
+		}
+	})
+}
+
+func TestIsSorted(t *testing.T) {
+	if !IsSorted([]int{1, 2, 2, 3}) || IsSorted([]int{2, 1}) {
+		t.Errorf("Expected IsSorted to detect order")
+	}
+	if !IsSorted([]int{}) || !IsSorted([]string{"x"}) {
+		t.Errorf("Expected empty and singleton slices to be sorted")
+	}
+	if !IsSortedBy(Ordering[int](CompareOrd[int]).Reversed(), []int{3, 2, 1}) {
+		t.Errorf("Expected [3 2 1] to be sorted in reverse")
+	}
+}
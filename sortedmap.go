@@ -0,0 +1,406 @@
+package functionalgo
+
+// SortedMap is a persistent map ordered by its keys, stored as a
+// weight-balanced binary tree as in Haskell's Data.Map. Insert, Delete,
+// Lookup, Split, Rank and Select are O(log n) and return new versions that
+// share all but one path with the original. The zero value is an empty map
+// ordered by Compare; use SortedMapBy for another ordering.
+type SortedMap[K any, V any] struct {
+	root *wbNode[K, V]
+	ord  Ordering[K]
+}
+
+type wbNode[K any, V any] struct {
+	key         K
+	value       V
+	left, right *wbNode[K, V]
+	size        int
+}
+
+// Balance parameters from Adams' weight-balanced trees: a subtree may be at
+// most wbDelta times the size of its sibling, and a double rotation is used
+// when the inner grandchild is at least wbRatio times the outer one.
+const (
+	wbDelta = 3
+	wbRatio = 2
+)
+
+func SortedMapBy[K any, V any](ord Ordering[K]) SortedMap[K, V] {
+	return SortedMap[K, V]{ord: ord}
+}
+
+// SortedMapFromTuples builds a map ordered by Compare. Later pairs overwrite
+// earlier pairs with the same key.
+func SortedMapFromTuples[K any, V any](src []Tuple[K, V]) SortedMap[K, V] {
+	var m SortedMap[K, V]
+	for _, t := range src {
+		m = m.Insert(t.fst, t.snd)
+	}
+	return m
+}
+
+func (m SortedMap[K, V]) compare() Ordering[K] {
+	if m.ord == nil {
+		return Compare[K]
+	}
+	return m.ord
+}
+
+func (m SortedMap[K, V]) with(root *wbNode[K, V]) SortedMap[K, V] {
+	return SortedMap[K, V]{root, m.ord}
+}
+
+func (m SortedMap[K, V]) Len() int {
+	return m.root.len()
+}
+
+func (m SortedMap[K, V]) IsEmpty() bool {
+	return m.root == nil
+}
+
+func (m SortedMap[K, V]) Lookup(key K) Option[V] {
+	ord := m.compare()
+	for n := m.root; n != nil; {
+		switch ord(key, n.key) {
+		case LT:
+			n = n.left
+		case GT:
+			n = n.right
+		default:
+			return Some(n.value)
+		}
+	}
+	return None[V]()
+}
+
+func (m SortedMap[K, V]) Contains(key K) bool {
+	return m.Lookup(key).IsSome()
+}
+
+// Insert returns a copy of m with key mapped to value.
+func (m SortedMap[K, V]) Insert(key K, value V) SortedMap[K, V] {
+	return m.with(m.root.insert(m.compare(), key, value))
+}
+
+// Delete returns a copy of m without key, or m itself if key is absent.
+func (m SortedMap[K, V]) Delete(key K) SortedMap[K, V] {
+	return m.with(m.root.delete(m.compare(), key))
+}
+
+func (m SortedMap[K, V]) Min() Option[Tuple[K, V]] {
+	if m.root == nil {
+		return None[Tuple[K, V]]()
+	}
+	n := m.root
+	for n.left != nil {
+		n = n.left
+	}
+	return Some(MkTuple(n.key, n.value))
+}
+
+func (m SortedMap[K, V]) Max() Option[Tuple[K, V]] {
+	if m.root == nil {
+		return None[Tuple[K, V]]()
+	}
+	n := m.root
+	for n.right != nil {
+		n = n.right
+	}
+	return Some(MkTuple(n.key, n.value))
+}
+
+// Floor returns the entry with the greatest key less than or equal to key.
+func (m SortedMap[K, V]) Floor(key K) Option[Tuple[K, V]] {
+	return m.bound(key, GT)
+}
+
+// Ceiling returns the entry with the least key greater than or equal to key.
+func (m SortedMap[K, V]) Ceiling(key K) Option[Tuple[K, V]] {
+	return m.bound(key, LT)
+}
+
+// bound finds the closest entry to key from the side opposite to past.
+func (m SortedMap[K, V]) bound(key K, past ComparisonResult) Option[Tuple[K, V]] {
+	ord := m.compare()
+	var best *wbNode[K, V]
+	for n := m.root; n != nil; {
+		c := ord(n.key, key)
+		if c == EQ {
+			return Some(MkTuple(n.key, n.value))
+		}
+		if c != past {
+			best = n
+		}
+		if c == LT {
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+	if best == nil {
+		return None[Tuple[K, V]]()
+	}
+	return Some(MkTuple(best.key, best.value))
+}
+
+// Range lazily yields the entries with keys from lo to hi inclusive, in
+// ascending order.
+func (m SortedMap[K, V]) Range(lo, hi K) Seq2[K, V] {
+	ord := m.compare()
+	return func(yield func(K, V) bool) {
+		m.root.between(ord, lo, hi, yield)
+	}
+}
+
+// Split returns the entries with keys less than key, the value of key if
+// present, and the entries with keys greater than key.
+func (m SortedMap[K, V]) Split(key K) (SortedMap[K, V], Option[V], SortedMap[K, V]) {
+	lt, found, gt := m.root.split(m.compare(), key)
+	return m.with(lt), found, m.with(gt)
+}
+
+// Rank returns the zero-based position of key in ascending order.
+func (m SortedMap[K, V]) Rank(key K) Option[int] {
+	ord := m.compare()
+	rank := 0
+	for n := m.root; n != nil; {
+		switch ord(key, n.key) {
+		case LT:
+			n = n.left
+		case GT:
+			rank += n.left.len() + 1
+			n = n.right
+		default:
+			return Some(rank + n.left.len())
+		}
+	}
+	return None[int]()
+}
+
+// Select returns the entry at zero-based position i in ascending order.
+func (m SortedMap[K, V]) Select(i int) Option[Tuple[K, V]] {
+	if i < 0 || i >= m.Len() {
+		return None[Tuple[K, V]]()
+	}
+	n := m.root
+	for {
+		switch l := n.left.len(); {
+		case i < l:
+			n = n.left
+		case i > l:
+			i -= l + 1
+			n = n.right
+		default:
+			return Some(MkTuple(n.key, n.value))
+		}
+	}
+}
+
+// Seq yields the entries in ascending key order.
+func (m SortedMap[K, V]) Seq() Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.root.ascend(yield)
+	}
+}
+
+// Descending yields the entries in descending key order.
+func (m SortedMap[K, V]) Descending() Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.root.descend(yield)
+	}
+}
+
+func (m SortedMap[K, V]) ToTuples() []Tuple[K, V] {
+	return Collect(FromSeq2(m.Seq()))
+}
+
+// FoldlSortedMap folds over the entries in ascending key order.
+func FoldlSortedMap[K any, V any, B any](fn func(B, K, V) B, acc B, m SortedMap[K, V]) B {
+	for k, v := range m.Seq() {
+		acc = fn(acc, k, v)
+	}
+	return acc
+}
+
+// FoldrSortedMap folds over the entries from the greatest key down.
+func FoldrSortedMap[K any, V any, B any](fn func(K, V, B) B, acc B, m SortedMap[K, V]) B {
+	for k, v := range m.Descending() {
+		acc = fn(k, v, acc)
+	}
+	return acc
+}
+
+func (n *wbNode[K, V]) len() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func newWBNode[K any, V any](key K, value V, left, right *wbNode[K, V]) *wbNode[K, V] {
+	return &wbNode[K, V]{key, value, left, right, left.len() + right.len() + 1}
+}
+
+// balanceWB builds a node from subtrees that were balanced before a single
+// insertion or deletion, rotating once if needed.
+func balanceWB[K any, V any](key K, value V, left, right *wbNode[K, V]) *wbNode[K, V] {
+	sl, sr := left.len(), right.len()
+	switch {
+	case sl+sr <= 1:
+		return newWBNode(key, value, left, right)
+	case sr > wbDelta*sl:
+		if right.left.len() < wbRatio*right.right.len() {
+			return newWBNode(right.key, right.value, newWBNode(key, value, left, right.left), right.right)
+		}
+		rl := right.left
+		return newWBNode(rl.key, rl.value, newWBNode(key, value, left, rl.left), newWBNode(right.key, right.value, rl.right, right.right))
+	case sl > wbDelta*sr:
+		if left.right.len() < wbRatio*left.left.len() {
+			return newWBNode(left.key, left.value, left.left, newWBNode(key, value, left.right, right))
+		}
+		lr := left.right
+		return newWBNode(lr.key, lr.value, newWBNode(left.key, left.value, left.left, lr.left), newWBNode(key, value, lr.right, right))
+	default:
+		return newWBNode(key, value, left, right)
+	}
+}
+
+// linkWB joins left, key and right, whose keys are in ascending order but
+// whose sizes may differ arbitrarily.
+func linkWB[K any, V any](key K, value V, left, right *wbNode[K, V]) *wbNode[K, V] {
+	switch {
+	case left == nil:
+		return right.insertMin(key, value)
+	case right == nil:
+		return left.insertMax(key, value)
+	case wbDelta*left.size < right.size:
+		return balanceWB(right.key, right.value, linkWB(key, value, left, right.left), right.right)
+	case wbDelta*right.size < left.size:
+		return balanceWB(left.key, left.value, left.left, linkWB(key, value, left.right, right))
+	default:
+		return newWBNode(key, value, left, right)
+	}
+}
+
+func (n *wbNode[K, V]) insertMin(key K, value V) *wbNode[K, V] {
+	if n == nil {
+		return newWBNode[K, V](key, value, nil, nil)
+	}
+	return balanceWB(n.key, n.value, n.left.insertMin(key, value), n.right)
+}
+
+func (n *wbNode[K, V]) insertMax(key K, value V) *wbNode[K, V] {
+	if n == nil {
+		return newWBNode[K, V](key, value, nil, nil)
+	}
+	return balanceWB(n.key, n.value, n.left, n.right.insertMax(key, value))
+}
+
+func (n *wbNode[K, V]) insert(ord Ordering[K], key K, value V) *wbNode[K, V] {
+	if n == nil {
+		return newWBNode[K, V](key, value, nil, nil)
+	}
+	switch ord(key, n.key) {
+	case LT:
+		return balanceWB(n.key, n.value, n.left.insert(ord, key, value), n.right)
+	case GT:
+		return balanceWB(n.key, n.value, n.left, n.right.insert(ord, key, value))
+	default:
+		return &wbNode[K, V]{key, value, n.left, n.right, n.size}
+	}
+}
+
+func (n *wbNode[K, V]) delete(ord Ordering[K], key K) *wbNode[K, V] {
+	if n == nil {
+		return nil
+	}
+	switch ord(key, n.key) {
+	case LT:
+		if left := n.left.delete(ord, key); left != n.left {
+			return balanceWB(n.key, n.value, left, n.right)
+		}
+	case GT:
+		if right := n.right.delete(ord, key); right != n.right {
+			return balanceWB(n.key, n.value, n.left, right)
+		}
+	default:
+		return glueWB(n.left, n.right)
+	}
+	return n
+}
+
+// glueWB joins two subtrees of a deleted node by moving the nearest entry of
+// the larger one to the root.
+func glueWB[K any, V any](left, right *wbNode[K, V]) *wbNode[K, V] {
+	switch {
+	case left == nil:
+		return right
+	case right == nil:
+		return left
+	case left.size > right.size:
+		maxNode := left
+		for maxNode.right != nil {
+			maxNode = maxNode.right
+		}
+		return balanceWB(maxNode.key, maxNode.value, left.deleteMax(), right)
+	default:
+		minNode := right
+		for minNode.left != nil {
+			minNode = minNode.left
+		}
+		return balanceWB(minNode.key, minNode.value, left, right.deleteMin())
+	}
+}
+
+func (n *wbNode[K, V]) deleteMin() *wbNode[K, V] {
+	if n.left == nil {
+		return n.right
+	}
+	return balanceWB(n.key, n.value, n.left.deleteMin(), n.right)
+}
+
+func (n *wbNode[K, V]) deleteMax() *wbNode[K, V] {
+	if n.right == nil {
+		return n.left
+	}
+	return balanceWB(n.key, n.value, n.left, n.right.deleteMax())
+}
+
+func (n *wbNode[K, V]) split(ord Ordering[K], key K) (*wbNode[K, V], Option[V], *wbNode[K, V]) {
+	if n == nil {
+		return nil, None[V](), nil
+	}
+	switch ord(key, n.key) {
+	case LT:
+		lt, found, gt := n.left.split(ord, key)
+		return lt, found, linkWB(n.key, n.value, gt, n.right)
+	case GT:
+		lt, found, gt := n.right.split(ord, key)
+		return linkWB(n.key, n.value, n.left, lt), found, gt
+	default:
+		return n.left, Some(n.value), n.right
+	}
+}
+
+func (n *wbNode[K, V]) ascend(yield func(K, V) bool) bool {
+	return n == nil || n.left.ascend(yield) && yield(n.key, n.value) && n.right.ascend(yield)
+}
+
+func (n *wbNode[K, V]) descend(yield func(K, V) bool) bool {
+	return n == nil || n.right.descend(yield) && yield(n.key, n.value) && n.left.descend(yield)
+}
+
+// between visits the entries from lo to hi, skipping subtrees outside them.
+func (n *wbNode[K, V]) between(ord Ordering[K], lo, hi K, yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	aboveLo, belowHi := ord(n.key, lo) != LT, ord(n.key, hi) != GT
+	if aboveLo && !n.left.between(ord, lo, hi, yield) {
+		return false
+	}
+	if aboveLo && belowHi && !yield(n.key, n.value) {
+		return false
+	}
+	return !belowHi || n.right.between(ord, lo, hi, yield)
+}
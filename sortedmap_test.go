@@ -0,0 +1,166 @@
+package functionalgo
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// checkSortedMap verifies the sizes and weight balance of m and compares its
+// entries with a model map.
+func checkSortedMap(t *testing.T, m SortedMap[int, int], model map[int]int) {
+	t.Helper()
+	var check func(n *wbNode[int, int]) int
+	check = func(n *wbNode[int, int]) int {
+		if n == nil {
+			return 0
+		}
+		l, r := check(n.left), check(n.right)
+		if n.size != l+r+1 {
+			t.Fatalf("Node %d has size %d, expected %d", n.key, n.size, l+r+1)
+		}
+		if l+r > 1 && (l > wbDelta*r || r > wbDelta*l) {
+			t.Fatalf("Node %d is unbalanced: %d vs %d", n.key, l, r)
+		}
+		return n.size
+	}
+	check(m.root)
+	if !reflect.DeepEqual(m.ToTuples(), FlattenSorted(model)) {
+		t.Fatalf("Expected %v, got %v", FlattenSorted(model), m.ToTuples())
+	}
+}
+
+func TestSortedMapBasics(t *testing.T) {
+	var empty SortedMap[string, int]
+	if empty.Len() != 0 || empty.Min().IsSome() || empty.Lookup("a").IsSome() {
+		t.Errorf("Expected the zero value to be an empty map")
+	}
+
+	m := SortedMapFromTuples([]Tuple[string, int]{MkTuple("b", 2), MkTuple("c", 3), MkTuple("a", 1)})
+	if v, ok := m.Lookup("b").Get(); !ok || v != 2 {
+		t.Errorf("Expected Some(2), got %v", m.Lookup("b"))
+	}
+	if keys := Collect(keysOf(m.Seq())); !reflect.DeepEqual(keys, []string{"a", "b", "c"}) {
+		t.Errorf("Expected ascending keys, got %v", keys)
+	}
+	if keys := Collect(keysOf(m.Descending())); !reflect.DeepEqual(keys, []string{"c", "b", "a"}) {
+		t.Errorf("Expected descending keys, got %v", keys)
+	}
+
+	m2 := m.Insert("b", 20).Delete("a")
+	if m.Len() != 3 || m2.Len() != 2 || m2.Lookup("b").OrElse(0) != 20 || m.Lookup("b").OrElse(0) != 2 {
+		t.Errorf("Expected updates to leave the original unchanged")
+	}
+	if m.Delete("zzz").root != m.root {
+		t.Errorf("Expected deleting a missing key to return the same map")
+	}
+}
+
+func TestSortedMapQueries(t *testing.T) {
+	var m SortedMap[int, string]
+	for _, k := range []int{10, 20, 30, 40, 50} {
+		m = m.Insert(k, "v")
+	}
+	key := func(o Option[Tuple[int, string]]) Option[int] { return MapOption(Fst[int, string], o) }
+
+	tests := []struct {
+		name     string
+		result   Option[int]
+		expected Option[int]
+	}{
+		{"min", key(m.Min()), Some(10)},
+		{"max", key(m.Max()), Some(50)},
+		{"floor exact", key(m.Floor(30)), Some(30)},
+		{"floor between", key(m.Floor(35)), Some(30)},
+		{"floor below", key(m.Floor(5)), None[int]()},
+		{"ceiling between", key(m.Ceiling(35)), Some(40)},
+		{"ceiling above", key(m.Ceiling(55)), None[int]()},
+		{"rank", m.Rank(40), Some(3)},
+		{"rank missing", m.Rank(41), None[int]()},
+		{"select", key(m.Select(1)), Some(20)},
+		{"select out of range", key(m.Select(5)), None[int]()},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tc.result, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, tc.result)
+			}
+		})
+	}
+
+	t.Run("range", func(t *testing.T) {
+		if keys := Collect(keysOf(m.Range(15, 40))); !reflect.DeepEqual(keys, []int{20, 30, 40}) {
+			t.Errorf("Expected [20 30 40], got %v", keys)
+		}
+		if keys := Collect(keysOf(m.Range(41, 49))); !reflect.DeepEqual(keys, []int{}) {
+			t.Errorf("Expected [], got %v", keys)
+		}
+		if keys := Collect(TakeSeq(keysOf(m.Range(0, 100)), 2)); !reflect.DeepEqual(keys, []int{10, 20}) {
+			t.Errorf("Expected [10 20], got %v", keys)
+		}
+	})
+
+	t.Run("split", func(t *testing.T) {
+		lt, found, gt := m.Split(30)
+		if !found.IsSome() || lt.Len() != 2 || gt.Len() != 2 || lt.Max().Unwrap().fst != 20 || gt.Min().Unwrap().fst != 40 {
+			t.Errorf("Expected [10 20] 30 [40 50], got %v %v %v", lt.ToTuples(), found, gt.ToTuples())
+		}
+		if _, found, _ := m.Split(31); found.IsSome() {
+			t.Errorf("Expected 31 to be absent")
+		}
+	})
+
+	t.Run("folds", func(t *testing.T) {
+		asc := FoldlSortedMap(func(acc []int, k int, _ string) []int { return append(acc, k) }, nil, m)
+		desc := FoldrSortedMap(func(k int, _ string, acc []int) []int { return append(acc, k) }, nil, m)
+		if !reflect.DeepEqual(asc, []int{10, 20, 30, 40, 50}) || !reflect.DeepEqual(desc, []int{50, 40, 30, 20, 10}) {
+			t.Errorf("Expected ascending and descending folds, got %v %v", asc, desc)
+		}
+	})
+}
+
+func TestSortedMapCustomOrdering(t *testing.T) {
+	m := SortedMapBy[string, int](Ordering[string](CompareOrd[string]).Reversed())
+	m = m.Insert("a", 1).Insert("c", 3).Insert("b", 2)
+	if keys := Collect(keysOf(m.Seq())); !reflect.DeepEqual(keys, []string{"c", "b", "a"}) {
+		t.Errorf("Expected [c b a], got %v", keys)
+	}
+}
+
+func TestSortedMapRandomOps(t *testing.T) {
+	rng := rand.New(rand.NewPCG(7, 8))
+	var m SortedMap[int, int]
+	model := map[int]int{}
+	for step := range 5000 {
+		k := rng.IntN(1000)
+		switch rng.IntN(10) {
+		case 0:
+			lt, _, gt := m.Split(k)
+			checkSortedMap(t, lt, FilterWithKey(func(key, _ int) bool { return key < k }, model))
+			checkSortedMap(t, gt, FilterWithKey(func(key, _ int) bool { return key > k }, model))
+		case 1, 2, 3:
+			m = m.Delete(k)
+			delete(model, k)
+		default:
+			m = m.Insert(k, step)
+			model[k] = step
+		}
+		if step%250 == 0 {
+			checkSortedMap(t, m, model)
+		}
+	}
+	checkSortedMap(t, m, model)
+
+	keys := Keys(model)
+	for i, k := range keys {
+		if rank, _ := m.Rank(k).Get(); rank != i {
+			t.Fatalf("Expected rank %d for %d, got %d", i, k, rank)
+		}
+	}
+	lo, hi := 250, 750
+	expected := slices.DeleteFunc(slices.Clone(keys), func(k int) bool { return k < lo || k > hi })
+	if result := Collect(keysOf(m.Range(lo, hi))); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected range %v, got %v", expected, result)
+	}
+}
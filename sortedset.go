@@ -0,0 +1,104 @@
+package functionalgo
+
+// SortedSet is a persistent ordered set backed by a SortedMap with empty
+// values. The zero value is an empty set ordered by Compare.
+type SortedSet[T any] struct {
+	m SortedMap[T, struct{}]
+}
+
+func SortedSetBy[T any](ord Ordering[T]) SortedSet[T] {
+	return SortedSet[T]{SortedMapBy[T, struct{}](ord)}
+}
+
+func SortedSetOf[T any](xs ...T) SortedSet[T] {
+	return SortedSetFromSlice(xs)
+}
+
+func SortedSetFromSlice[T any](src []T) SortedSet[T] {
+	var s SortedSet[T]
+	for _, x := range src {
+		s = s.Insert(x)
+	}
+	return s
+}
+
+func (s SortedSet[T]) Len() int {
+	return s.m.Len()
+}
+
+func (s SortedSet[T]) IsEmpty() bool {
+	return s.m.IsEmpty()
+}
+
+func (s SortedSet[T]) Contains(x T) bool {
+	return s.m.Contains(x)
+}
+
+func (s SortedSet[T]) Insert(x T) SortedSet[T] {
+	return SortedSet[T]{s.m.Insert(x, struct{}{})}
+}
+
+func (s SortedSet[T]) Delete(x T) SortedSet[T] {
+	return SortedSet[T]{s.m.Delete(x)}
+}
+
+func (s SortedSet[T]) Min() Option[T] {
+	return MapOption(Fst[T, struct{}], s.m.Min())
+}
+
+func (s SortedSet[T]) Max() Option[T] {
+	return MapOption(Fst[T, struct{}], s.m.Max())
+}
+
+// Floor returns the greatest element less than or equal to x.
+func (s SortedSet[T]) Floor(x T) Option[T] {
+	return MapOption(Fst[T, struct{}], s.m.Floor(x))
+}
+
+// Ceiling returns the least element greater than or equal to x.
+func (s SortedSet[T]) Ceiling(x T) Option[T] {
+	return MapOption(Fst[T, struct{}], s.m.Ceiling(x))
+}
+
+// Range lazily yields the elements from lo to hi inclusive, in ascending
+// order.
+func (s SortedSet[T]) Range(lo, hi T) Seq[T] {
+	return keysOf(s.m.Range(lo, hi))
+}
+
+// Split returns the elements less than x, whether x is present, and the
+// elements greater than x.
+func (s SortedSet[T]) Split(x T) (SortedSet[T], bool, SortedSet[T]) {
+	lt, found, gt := s.m.Split(x)
+	return SortedSet[T]{lt}, found.IsSome(), SortedSet[T]{gt}
+}
+
+func (s SortedSet[T]) Rank(x T) Option[int] {
+	return s.m.Rank(x)
+}
+
+func (s SortedSet[T]) Select(i int) Option[T] {
+	return MapOption(Fst[T, struct{}], s.m.Select(i))
+}
+
+func (s SortedSet[T]) Seq() Seq[T] {
+	return keysOf(s.m.Seq())
+}
+
+func (s SortedSet[T]) Descending() Seq[T] {
+	return keysOf(s.m.Descending())
+}
+
+func (s SortedSet[T]) ToSlice() []T {
+	return Collect(s.Seq())
+}
+
+func keysOf[K any, V any](seq Seq2[K, V]) Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range seq {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
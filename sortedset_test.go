@@ -0,0 +1,43 @@
+package functionalgo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSortedSet(t *testing.T) {
+	s := SortedSetOf(5, 1, 3, 1, 9)
+	if !reflect.DeepEqual(s.ToSlice(), []int{1, 3, 5, 9}) || s.Len() != 4 {
+		t.Errorf("Expected [1 3 5 9], got %v", s.ToSlice())
+	}
+	if !reflect.DeepEqual(Collect(s.Descending()), []int{9, 5, 3, 1}) {
+		t.Errorf("Expected [9 5 3 1], got %v", Collect(s.Descending()))
+	}
+	if s.Min().Unwrap() != 1 || s.Max().Unwrap() != 9 || s.Floor(4).Unwrap() != 3 || s.Ceiling(4).Unwrap() != 5 {
+		t.Errorf("Unexpected Min, Max, Floor or Ceiling")
+	}
+	if !reflect.DeepEqual(Collect(s.Range(2, 5)), []int{3, 5}) {
+		t.Errorf("Expected [3 5], got %v", Collect(s.Range(2, 5)))
+	}
+	if s.Rank(5).Unwrap() != 2 || s.Select(0).Unwrap() != 1 {
+		t.Errorf("Unexpected Rank or Select")
+	}
+
+	lt, found, gt := s.Split(3)
+	if !found || !reflect.DeepEqual(lt.ToSlice(), []int{1}) || !reflect.DeepEqual(gt.ToSlice(), []int{5, 9}) {
+		t.Errorf("Expected [1] true [5 9], got %v %v %v", lt.ToSlice(), found, gt.ToSlice())
+	}
+
+	d := s.Delete(3).Insert(4)
+	if !reflect.DeepEqual(d.ToSlice(), []int{1, 4, 5, 9}) || !s.Contains(3) {
+		t.Errorf("Expected updates to leave the original unchanged")
+	}
+
+	t.Run("custom ordering", func(t *testing.T) {
+		byLen := SortedSetBy(Comparing(func(s string) int { return len(s) }))
+		byLen = byLen.Insert("ccc").Insert("a").Insert("bb").Insert("zz")
+		if !reflect.DeepEqual(byLen.ToSlice(), []string{"a", "zz", "ccc"}) {
+			t.Errorf("Expected elements equal under the ordering to be merged, got %v", byLen.ToSlice())
+		}
+	})
+}
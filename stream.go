@@ -0,0 +1,105 @@
+package functionalgo
+
+import "sync"
+
+// stream is a lazy list whose cells are computed on first use and memoised,
+// so versions of a persistent structure that share a stream never repeat its
+// work. This is what makes the amortised bounds of Queue and the worst-case
+// bounds of Deque hold when old versions are reused. A nil stream is empty.
+type stream[T any] struct {
+	once  sync.Once
+	thunk func() *streamCell[T]
+	cell  *streamCell[T]
+}
+
+// streamCell is an evaluated non-empty stream; a nil cell is the end.
+type streamCell[T any] struct {
+	head T
+	tail *stream[T]
+}
+
+func lazyStream[T any](thunk func() *streamCell[T]) *stream[T] {
+	return &stream[T]{thunk: thunk}
+}
+
+func (s *stream[T]) force() *streamCell[T] {
+	if s == nil {
+		return nil
+	}
+	s.once.Do(func() {
+		s.cell = s.thunk()
+		s.thunk = nil
+	})
+	return s.cell
+}
+
+func consStream[T any](x T, s *stream[T]) *stream[T] {
+	result := &stream[T]{}
+	result.once.Do(func() { result.cell = &streamCell[T]{x, s} })
+	return result
+}
+
+func sliceStream[T any](src []T) *stream[T] {
+	var s *stream[T]
+	for i := len(src) - 1; i >= 0; i-- {
+		s = consStream(src[i], s)
+	}
+	return s
+}
+
+// appendStream is incremental: each cell of s is copied only when reached.
+func appendStream[T any](s, t *stream[T]) *stream[T] {
+	return lazyStream(func() *streamCell[T] {
+		c := s.force()
+		if c == nil {
+			return t.force()
+		}
+		return &streamCell[T]{c.head, appendStream(c.tail, t)}
+	})
+}
+
+// reverseStream is monolithic: forcing its first cell reverses all of s.
+func reverseStream[T any](s *stream[T]) *stream[T] {
+	return lazyStream(func() *streamCell[T] {
+		var r *stream[T]
+		for c := s.force(); c != nil; c = c.tail.force() {
+			r = consStream(c.head, r)
+		}
+		return r.force()
+	})
+}
+
+func takeStream[T any](n int, s *stream[T]) *stream[T] {
+	return lazyStream(func() *streamCell[T] {
+		if n <= 0 {
+			return nil
+		}
+		c := s.force()
+		if c == nil {
+			return nil
+		}
+		return &streamCell[T]{c.head, takeStream(n-1, c.tail)}
+	})
+}
+
+// dropStream forces the first n cells of s.
+func dropStream[T any](n int, s *stream[T]) *stream[T] {
+	for ; n > 0; n-- {
+		c := s.force()
+		if c == nil {
+			return nil
+		}
+		s = c.tail
+	}
+	return s
+}
+
+func (s *stream[T]) seq() Seq[T] {
+	return func(yield func(T) bool) {
+		for c := s.force(); c != nil; c = c.tail.force() {
+			if !yield(c.head) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package functionalgo
+
+// The sublist functions return views of src rather than copies, following
+// Haskell's clamping rules: negative counts act as zero and counts beyond the
+// length act as the length. Every returned prefix has its capacity clipped, so
+// appending to it allocates instead of overwriting the rest of src.
+
+func Take[A any, B ~[]A](src B, num int) B {
+	num = clamp(num, len(src))
+	return src[:num:num]
+}
+
+func Drop[A any, B ~[]A](src B, num int) B {
+	return src[clamp(num, len(src)):]
+}
+
+func TakeEnd[A any, B ~[]A](src B, num int) B {
+	return src[len(src)-clamp(num, len(src)):]
+}
+
+func DropEnd[A any, B ~[]A](src B, num int) B {
+	end := len(src) - clamp(num, len(src))
+	return src[:end:end]
+}
+
+func SplitAt[A any, B ~[]A](src B, num int) (B, B) {
+	return Take(src, num), Drop(src, num)
+}
+
+func TakeWhile[A any, B ~[]A](fn func(A) bool, src B) B {
+	return Take(src, prefixLength(fn, src))
+}
+
+func DropWhile[A any, B ~[]A](fn func(A) bool, src B) B {
+	return Drop(src, prefixLength(fn, src))
+}
+
+// DropWhileEnd drops the longest suffix whose elements satisfy fn.
+func DropWhileEnd[A any, B ~[]A](fn func(A) bool, src B) B {
+	end := len(src)
+	for end > 0 && fn(src[end-1]) {
+		end--
+	}
+	return src[:end:end]
+}
+
+// Span splits src at the first element that does not satisfy fn.
+func Span[A any, B ~[]A](fn func(A) bool, src B) (B, B) {
+	return SplitAt(src, prefixLength(fn, src))
+}
+
+// Break splits src at the first element that satisfies fn.
+func Break[A any, B ~[]A](fn func(A) bool, src B) (B, B) {
+	return Span(func(a A) bool { return !fn(a) }, src)
+}
+
+func StripPrefix[A comparable](prefix []A, src []A) Option[[]A] {
+	if !hasPrefix(src, prefix) {
+		return None[[]A]()
+	}
+	return Some(src[len(prefix):])
+}
+
+func Init[A any, B ~[]A](src B) B {
+	if len(src) == 0 {
+		panic("cannot take init of empty list")
+	}
+	return DropEnd(src, 1)
+}
+
+// Inits returns every prefix of src, shortest first, starting with the empty
+// prefix.
+func Inits[A any](src []A) [][]A {
+	result := make([][]A, len(src)+1)
+	for i := range result {
+		result[i] = Take(src, i)
+	}
+	return result
+}
+
+// Tails returns every suffix of src, longest first, ending with the empty
+// suffix.
+func Tails[A any](src []A) [][]A {
+	result := make([][]A, len(src)+1)
+	for i := range result {
+		result[i] = Drop(src, i)
+	}
+	return result
+}
+
+func prefixLength[A any](fn func(A) bool, src []A) int {
+	for i, v := range src {
+		if !fn(v) {
+			return i
+		}
+	}
+	return len(src)
+}
+
+func clamp(num, length int) int {
+	return max(0, min(num, length))
+}
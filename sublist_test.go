@@ -0,0 +1,193 @@
+package functionalgo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTakeDropGeneric(t *testing.T) {
+	src := []int{1, 2, 3, 4, 5}
+
+	t.Run("take ints", func(t *testing.T) {
+		result := Take(src, 2)
+		if !reflect.DeepEqual(result, []int{1, 2}) {
+			t.Errorf("Expected [1 2], got %v", result)
+		}
+	})
+
+	t.Run("drop ints", func(t *testing.T) {
+		result := Drop(src, 2)
+		if !reflect.DeepEqual(result, []int{3, 4, 5}) {
+			t.Errorf("Expected [3 4 5], got %v", result)
+		}
+	})
+
+	t.Run("appending to take does not clobber source", func(t *testing.T) {
+		src := []int{1, 2, 3}
+		_ = append(Take(src, 1), 99)
+		if !reflect.DeepEqual(src, []int{1, 2, 3}) {
+			t.Errorf("Expected source to be unchanged, got %v", src)
+		}
+	})
+
+	t.Run("named slice types are kept", func(t *testing.T) {
+		type path []string
+		p := path{"usr", "local", "bin"}
+		isLocal := func(s string) bool { return s != "bin" }
+		var results []path
+		results = append(results, Take(p, 1), Drop(p, 1), TakeEnd(p, 1), DropEnd(p, 1))
+		results = append(results, TakeWhile(isLocal, p), DropWhile(isLocal, p), DropWhileEnd(isLocal, p), Init(p))
+		before, after := SplitAt(p, 2)
+		spanned, rest := Span(isLocal, p)
+		broken, tail := Break(isLocal, p)
+		results = append(results, before, after, spanned, rest, broken, tail)
+		expected := []path{
+			{"usr"}, {"local", "bin"}, {"bin"}, {"usr", "local"},
+			{"usr", "local"}, {"bin"}, {"usr", "local", "bin"}, {"usr", "local"},
+			{"usr", "local"}, {"bin"}, {"usr", "local"}, {"bin"}, {}, {"usr", "local", "bin"},
+		}
+		if !reflect.DeepEqual(results, expected) {
+			t.Errorf("Expected %v, got %v", expected, results)
+		}
+	})
+}
+
+func TestTakeEndDropEnd(t *testing.T) {
+	tests := []struct {
+		name    string
+		num     int
+		takeEnd []int
+		dropEnd []int
+	}{
+		{"inside range", 2, []int{3, 4}, []int{1, 2}},
+		{"zero", 0, []int{}, []int{1, 2, 3, 4}},
+		{"negative", -3, []int{}, []int{1, 2, 3, 4}},
+		{"beyond length", 10, []int{1, 2, 3, 4}, []int{}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			src := []int{1, 2, 3, 4}
+			if result := TakeEnd(src, tc.num); !reflect.DeepEqual(result, tc.takeEnd) {
+				t.Errorf("TakeEnd: expected %v, got %v", tc.takeEnd, result)
+			}
+			if result := DropEnd(src, tc.num); !reflect.DeepEqual(result, tc.dropEnd) {
+				t.Errorf("DropEnd: expected %v, got %v", tc.dropEnd, result)
+			}
+		})
+	}
+}
+
+func TestSplitAt(t *testing.T) {
+	tests := []struct {
+		name        string
+		num         int
+		left, right []int
+	}{
+		{"middle", 1, []int{1}, []int{2, 3}},
+		{"negative", -1, []int{}, []int{1, 2, 3}},
+		{"beyond length", 5, []int{1, 2, 3}, []int{}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			left, right := SplitAt([]int{1, 2, 3}, tc.num)
+			if !reflect.DeepEqual(left, tc.left) || !reflect.DeepEqual(right, tc.right) {
+				t.Errorf("Expected %v %v, got %v %v", tc.left, tc.right, left, right)
+			}
+		})
+	}
+}
+
+func TestTakeWhileDropWhile(t *testing.T) {
+	lessThan3 := func(x int) bool { return x < 3 }
+	src := []int{1, 2, 3, 4, 1, 2}
+
+	if result := TakeWhile(lessThan3, src); !reflect.DeepEqual(result, []int{1, 2}) {
+		t.Errorf("Expected [1 2], got %v", result)
+	}
+	if result := DropWhile(lessThan3, src); !reflect.DeepEqual(result, []int{3, 4, 1, 2}) {
+		t.Errorf("Expected [3 4 1 2], got %v", result)
+	}
+	if result := TakeWhile(lessThan3, []int{}); !reflect.DeepEqual(result, []int{}) {
+		t.Errorf("Expected [], got %v", result)
+	}
+	if result := DropWhile(func(int) bool { return true }, src); !reflect.DeepEqual(result, []int{}) {
+		t.Errorf("Expected [], got %v", result)
+	}
+}
+
+func TestDropWhileEnd(t *testing.T) {
+	isSpace := func(r rune) bool { return r == ' ' }
+	result := string(DropWhileEnd(isSpace, []rune("foo bar  ")))
+	if result != "foo bar" {
+		t.Errorf("Expected 'foo bar', got %q", result)
+	}
+	if result := DropWhileEnd(isSpace, []rune("   ")); len(result) != 0 {
+		t.Errorf("Expected empty result, got %q", string(result))
+	}
+}
+
+func TestSpanBreak(t *testing.T) {
+	src := []int{1, 2, 3, 4, 1, 2, 3, 4}
+
+	left, right := Span(func(x int) bool { return x < 3 }, src)
+	if !reflect.DeepEqual(left, []int{1, 2}) || !reflect.DeepEqual(right, []int{3, 4, 1, 2, 3, 4}) {
+		t.Errorf("Span: expected [1 2] [3 4 1 2 3 4], got %v %v", left, right)
+	}
+
+	left, right = Break(func(x int) bool { return x > 3 }, src)
+	if !reflect.DeepEqual(left, []int{1, 2, 3}) || !reflect.DeepEqual(right, []int{4, 1, 2, 3, 4}) {
+		t.Errorf("Break: expected [1 2 3] [4 1 2 3 4], got %v %v", left, right)
+	}
+
+	left, right = Break(func(x int) bool { return x > 9 }, []int{1, 2})
+	if !reflect.DeepEqual(left, []int{1, 2}) || !reflect.DeepEqual(right, []int{}) {
+		t.Errorf("Break: expected [1 2] [], got %v %v", left, right)
+	}
+}
+
+func TestStripPrefix(t *testing.T) {
+	result, ok := StripPrefix([]rune("foo"), []rune("foobar")).Get()
+	if !ok || string(result) != "bar" {
+		t.Errorf("Expected Some(bar), got %q", string(result))
+	}
+	if StripPrefix([]rune("foo"), []rune("barfoo")).IsSome() {
+		t.Errorf("Expected None for mismatching prefix")
+	}
+	if StripPrefix([]int{1, 2, 3}, []int{1, 2}).IsSome() {
+		t.Errorf("Expected None for prefix longer than source")
+	}
+	if result, ok := StripPrefix([]int{}, []int{1}).Get(); !ok || !reflect.DeepEqual(result, []int{1}) {
+		t.Errorf("Expected Some([1]), got %v", result)
+	}
+}
+
+func TestInit(t *testing.T) {
+	if result := Init([]int{1, 2, 3}); !reflect.DeepEqual(result, []int{1, 2}) {
+		t.Errorf("Expected [1 2], got %v", result)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected Init of empty slice to panic")
+		}
+	}()
+	Init([]int{})
+}
+
+func TestInitsTails(t *testing.T) {
+	inits := Inits([]int{1, 2, 3})
+	expectedInits := [][]int{{}, {1}, {1, 2}, {1, 2, 3}}
+	if !reflect.DeepEqual(inits, expectedInits) {
+		t.Errorf("Expected %v, got %v", expectedInits, inits)
+	}
+
+	tails := Tails([]int{1, 2, 3})
+	expectedTails := [][]int{{1, 2, 3}, {2, 3}, {3}, {}}
+	if !reflect.DeepEqual(tails, expectedTails) {
+		t.Errorf("Expected %v, got %v", expectedTails, tails)
+	}
+
+	if result := Tails([]int{}); !reflect.DeepEqual(result, [][]int{{}}) {
+		t.Errorf("Expected [[]], got %v", result)
+	}
+}
@@ -0,0 +1,128 @@
+package functionalgo
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+)
+
+// The text functions work on code points: they do not treat combining
+// characters or other multi-rune graphemes as a unit.
+
+// Words splits s around runs of Unicode white space.
+func Words(s string) []string {
+	return strings.Fields(s)
+}
+
+func Unwords(words []string) string {
+	return strings.Join(words, " ")
+}
+
+// Lines splits s into lines, dropping the "\n" or "\r\n" that ends each one.
+// A trailing newline does not start an extra empty line.
+func Lines(s string) []string {
+	return Collect(LinesSeq(s))
+}
+
+// Unlines joins lines, ending each one with "\n".
+func Unlines(lines []string) string {
+	var sb strings.Builder
+	for _, line := range lines {
+		sb.WriteString(line)
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+func Runes(s string) []rune {
+	return []rune(s)
+}
+
+func FromRunes(src []rune) string {
+	return string(src)
+}
+
+// Reverse returns a reversed copy of src.
+func Reverse[A any](src []A) []A {
+	result := make([]A, len(src))
+	for i, v := range src {
+		result[len(src)-1-i] = v
+	}
+	return result
+}
+
+// ReverseString reverses s rune by rune.
+func ReverseString(s string) string {
+	return string(Reverse([]rune(s)))
+}
+
+// RunesSeq yields the runes of s. Invalid UTF-8 yields utf8.RuneError.
+func RunesSeq(s string) Seq[rune] {
+	return func(yield func(rune) bool) {
+		for _, r := range s {
+			if !yield(r) {
+				return
+			}
+		}
+	}
+}
+
+func LinesSeq(s string) Seq[string] {
+	return func(yield func(string) bool) {
+		for len(s) > 0 {
+			line, rest, _ := strings.Cut(s, "\n")
+			if !yield(strings.TrimSuffix(line, "\r")) {
+				return
+			}
+			s = rest
+		}
+	}
+}
+
+// ReaderRunes returns a sequence of the runes read from r, and a function
+// reporting the read error, if any, once the sequence has ended. Invalid UTF-8
+// yields utf8.RuneError. The sequence consumes r, so it can only be iterated
+// once.
+func ReaderRunes(r io.Reader) (Seq[rune], func() error) {
+	var err error
+	seq := func(yield func(rune) bool) {
+		br := bufio.NewReader(r)
+		for {
+			c, _, readErr := br.ReadRune()
+			if readErr != nil {
+				if !errors.Is(readErr, io.EOF) {
+					err = readErr
+				}
+				return
+			}
+			if !yield(c) {
+				return
+			}
+		}
+	}
+	return seq, func() error { return err }
+}
+
+// ReaderLines is Lines over the contents of r, with the same single-use and
+// error reporting behaviour as ReaderRunes. Lines may be of any length.
+func ReaderLines(r io.Reader) (Seq[string], func() error) {
+	var err error
+	seq := func(yield func(string) bool) {
+		br := bufio.NewReader(r)
+		for {
+			line, readErr := br.ReadString('\n')
+			if readErr != nil && !errors.Is(readErr, io.EOF) {
+				err = readErr
+				return
+			}
+			if line != "" && !yield(strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")) {
+				return
+			}
+			if readErr != nil {
+				return
+			}
+		}
+	}
+	return seq, func() error { return err }
+}
@@ -0,0 +1,112 @@
+package functionalgo
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"unicode"
+)
+
+func TestWords(t *testing.T) {
+	result := Words("  the quick\tbrown\n fox ")
+	expected := []string{"the", "quick", "brown", "fox"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+	if result := Unwords(expected); result != "the quick brown fox" {
+		t.Errorf("Expected 'the quick brown fox', got %q", result)
+	}
+}
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{"empty", "", []string{}},
+		{"no trailing newline", "a\nb", []string{"a", "b"}},
+		{"trailing newline", "a\nb\n", []string{"a", "b"}},
+		{"blank lines", "a\n\nb\n\n", []string{"a", "", "b", ""}},
+		{"crlf", "a\r\nb\r\n", []string{"a", "b"}},
+		{"lone carriage return kept", "a\rb\n", []string{"a\rb"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if result := Lines(tc.input); !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("Expected %q, got %q", tc.expected, result)
+			}
+		})
+	}
+
+	if result := Unlines([]string{"a", "b"}); result != "a\nb\n" {
+		t.Errorf("Expected \"a\\nb\\n\", got %q", result)
+	}
+}
+
+func TestRunesAndReverse(t *testing.T) {
+	if result := FromRunes(Filter(unicode.IsUpper, Runes("Hello, World"))); result != "HW" {
+		t.Errorf("Expected HW, got %q", result)
+	}
+	if result := ReverseString("héllo, 世界"); result != "界世 ,olléh" {
+		t.Errorf("Expected '界世 ,olléh', got %q", result)
+	}
+	src := []int{1, 2, 3}
+	if result := Reverse(src); !reflect.DeepEqual(result, []int{3, 2, 1}) || src[0] != 1 {
+		t.Errorf("Expected [3 2 1] and an unchanged source, got %v %v", result, src)
+	}
+}
+
+func TestRunesSeq(t *testing.T) {
+	vowels := FilterSeq(func(r rune) bool { return strings.ContainsRune("aeiou", r) }, RunesSeq("functional"))
+	if result := string(Collect(vowels)); result != "uioa" {
+		t.Errorf("Expected uioa, got %q", result)
+	}
+	if result := Collect(TakeSeq(LinesSeq("x\ny\nz"), 2)); !reflect.DeepEqual(result, []string{"x", "y"}) {
+		t.Errorf("Expected [x y], got %v", result)
+	}
+}
+
+func TestReaderRunes(t *testing.T) {
+	seq, errFn := ReaderRunes(strings.NewReader("añb"))
+	if result := string(Collect(seq)); result != "añb" {
+		t.Errorf("Expected añb, got %q", result)
+	}
+	if err := errFn(); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+
+	t.Run("read error", func(t *testing.T) {
+		boom := errors.New("boom")
+		seq, errFn := ReaderRunes(io.MultiReader(strings.NewReader("ab"), iotest.ErrReader(boom)))
+		if result := string(Collect(seq)); result != "ab" {
+			t.Errorf("Expected ab, got %q", result)
+		}
+		if err := errFn(); !errors.Is(err, boom) {
+			t.Errorf("Expected boom, got %v", err)
+		}
+	})
+}
+
+func TestReaderLines(t *testing.T) {
+	long := strings.Repeat("x", 100_000)
+	seq, errFn := ReaderLines(strings.NewReader("first\r\n" + long + "\n\nlast"))
+	result := Collect(seq)
+	expected := []string{"first", long, "", "last"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %d lines ending in 'last', got %d lines", len(expected), len(result))
+	}
+	if err := errFn(); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+
+	t.Run("stops early", func(t *testing.T) {
+		seq, _ := ReaderLines(strings.NewReader("a\nb\nc\n"))
+		if result := Collect(TakeSeq(seq, 1)); !reflect.DeepEqual(result, []string{"a"}) {
+			t.Errorf("Expected [a], got %v", result)
+		}
+	})
+}
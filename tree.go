@@ -0,0 +1,226 @@
+package functionalgo
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+)
+
+// Tree is a rose tree: a value with any number of ordered subtrees, as in
+// Haskell's Data.Tree. Trees are treated as immutable; every operation
+// returns a new tree.
+type Tree[T any] struct {
+	Value    T
+	Children []Tree[T]
+}
+
+func MkTree[T any](value T, children ...Tree[T]) Tree[T] {
+	return Tree[T]{value, children}
+}
+
+func (t Tree[T]) IsLeaf() bool {
+	return len(t.Children) == 0
+}
+
+// Size is the number of nodes in t.
+func (t Tree[T]) Size() int {
+	return FoldTree(func(_ T, sizes []int) int { return Sum(sizes) + 1 }, t)
+}
+
+// Depth is the number of nodes on the longest path from the root to a
+// leaf; a single node has depth 1.
+func (t Tree[T]) Depth() int {
+	return FoldTree(func(_ T, depths []int) int {
+		if len(depths) == 0 {
+			return 1
+		}
+		return Maximum(depths) + 1
+	}, t)
+}
+
+// Seq yields the values in pre-order.
+func (t Tree[T]) Seq() Seq[T] {
+	return func(yield func(T) bool) {
+		t.each(yield)
+	}
+}
+
+func (t Tree[T]) each(yield func(T) bool) bool {
+	if !yield(t.Value) {
+		return false
+	}
+	for _, c := range t.Children {
+		if !c.each(yield) {
+			return false
+		}
+	}
+	return true
+}
+
+// PreOrder lists each value before the values of its children.
+func (t Tree[T]) PreOrder() []T {
+	return Collect(t.Seq())
+}
+
+// PostOrder lists each value after the values of its children.
+func (t Tree[T]) PostOrder() []T {
+	var result []T
+	var walk func(Tree[T])
+	walk = func(n Tree[T]) {
+		for _, c := range n.Children {
+			walk(c)
+		}
+		result = append(result, n.Value)
+	}
+	walk(t)
+	return result
+}
+
+// LevelOrder lists the values breadth first.
+func (t Tree[T]) LevelOrder() []T {
+	return slices.Concat(t.Levels()...)
+}
+
+// Levels groups the values by depth, starting with the root.
+func (t Tree[T]) Levels() [][]T {
+	var result [][]T
+	for level := []Tree[T]{t}; len(level) > 0; {
+		var next []Tree[T]
+		values := make([]T, len(level))
+		for i, n := range level {
+			values[i] = n.Value
+			next = append(next, n.Children...)
+		}
+		result = append(result, values)
+		level = next
+	}
+	return result
+}
+
+// Paths lists the values on every path from the root to a leaf, leftmost
+// first.
+func (t Tree[T]) Paths() [][]T {
+	if t.IsLeaf() {
+		return [][]T{{t.Value}}
+	}
+	var result [][]T
+	for _, c := range t.Children {
+		for _, p := range c.Paths() {
+			result = append(result, append([]T{t.Value}, p...))
+		}
+	}
+	return result
+}
+
+// Prune removes every subtree whose root fails fn. It returns None if the
+// root itself fails.
+func (t Tree[T]) Prune(fn func(T) bool) Option[Tree[T]] {
+	if !fn(t.Value) {
+		return None[Tree[T]]()
+	}
+	var children []Tree[T]
+	for _, c := range t.Children {
+		if pruned, ok := c.Prune(fn).Get(); ok {
+			children = append(children, pruned)
+		}
+	}
+	return Some(Tree[T]{t.Value, children})
+}
+
+func MapTree[A any, B any](fn func(A) B, t Tree[A]) Tree[B] {
+	return FoldTree(func(x A, children []Tree[B]) Tree[B] {
+		return Tree[B]{fn(x), children}
+	}, t)
+}
+
+// FoldTree folds a tree bottom up, as Haskell's foldTree: fn receives each
+// value with the already folded results of its children.
+func FoldTree[A any, B any](fn func(A, []B) B, t Tree[A]) B {
+	var results []B
+	if len(t.Children) > 0 {
+		results = make([]B, len(t.Children))
+		for i, c := range t.Children {
+			results[i] = FoldTree(fn, c)
+		}
+	}
+	return fn(t.Value, results)
+}
+
+// UnfoldTree builds a tree from a seed: fn returns the value for a seed
+// and the seeds of its children.
+func UnfoldTree[A any, S any](fn func(S) (A, []S), seed S) Tree[A] {
+	value, seeds := fn(seed)
+	var children []Tree[A]
+	if len(seeds) > 0 {
+		children = make([]Tree[A], len(seeds))
+		for i, s := range seeds {
+			children[i] = UnfoldTree(fn, s)
+		}
+	}
+	return Tree[A]{value, children}
+}
+
+// DrawTree renders t as ASCII art in the style of Haskell's drawTree:
+//
+//	1
+//	|
+//	+- 2
+//	|  |
+//	|  `- 4
+//	|
+//	`- 3
+func DrawTree[T any](t Tree[T]) string {
+	return Unlines(drawTree(t))
+}
+
+func drawTree[T any](t Tree[T]) []string {
+	result := Lines(fmt.Sprint(t.Value))
+	if len(result) == 0 {
+		result = []string{""}
+	}
+	for i, c := range t.Children {
+		first, other := "+- ", "|  "
+		if i == len(t.Children)-1 {
+			first, other = "`- ", "   "
+		}
+		result = append(result, "|")
+		for j, line := range drawTree(c) {
+			if j == 0 {
+				result = append(result, first+line)
+			} else {
+				result = append(result, other+line)
+			}
+		}
+	}
+	return result
+}
+
+// TreeFromFS builds the tree of file and directory names below root in
+// fsys. The root node holds root itself; children are in fs.ReadDir order,
+// which is sorted by name.
+func TreeFromFS(fsys fs.FS, root string) (Tree[string], error) {
+	info, err := fs.Stat(fsys, root)
+	if err != nil {
+		return Tree[string]{}, err
+	}
+	if !info.IsDir() {
+		return Tree[string]{Value: root}, nil
+	}
+	entries, err := fs.ReadDir(fsys, root)
+	if err != nil {
+		return Tree[string]{}, err
+	}
+	t := Tree[string]{Value: root}
+	for _, e := range entries {
+		var child Tree[string]
+		if e.IsDir() {
+			if child, err = TreeFromFS(fsys, path.Join(root, e.Name())); err != nil {
+				return Tree[string]{}, err
+			}
+		}
+		child.Value = e.Name()
+		t.Children = append(t.Children, child)
+	}
+	return t, nil
+}
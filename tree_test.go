@@ -0,0 +1,141 @@
+package functionalgo
+
+import (
+	"errors"
+	"io/fs"
+	"reflect"
+	"strconv"
+	"testing"
+	"testing/fstest"
+)
+
+func sampleTree() Tree[int] {
+	return MkTree(1,
+		MkTree(2, MkTree(4), MkTree(5)),
+		MkTree(3, MkTree(6, MkTree(7))),
+	)
+}
+
+func TestTreeTraversals(t *testing.T) {
+	tree := sampleTree()
+	for _, tc := range []struct {
+		name     string
+		result   any
+		expected any
+	}{
+		{"pre-order", tree.PreOrder(), []int{1, 2, 4, 5, 3, 6, 7}},
+		{"post-order", tree.PostOrder(), []int{4, 5, 2, 7, 6, 3, 1}},
+		{"level-order", tree.LevelOrder(), []int{1, 2, 3, 4, 5, 6, 7}},
+		{"levels", tree.Levels(), [][]int{{1}, {2, 3}, {4, 5, 6}, {7}}},
+		{"paths", tree.Paths(), [][]int{{1, 2, 4}, {1, 2, 5}, {1, 3, 6, 7}}},
+		{"depth", tree.Depth(), 4},
+		{"size", tree.Size(), 7},
+		{"seq", Collect(TakeSeq(tree.Seq(), 3)), []int{1, 2, 4}},
+		{"single node", MkTree("x").Levels(), [][]string{{"x"}}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tc.result, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, tc.result)
+			}
+		})
+	}
+}
+
+func TestTreeFolds(t *testing.T) {
+	tree := sampleTree()
+
+	t.Run("map", func(t *testing.T) {
+		result := MapTree(strconv.Itoa, tree)
+		if !reflect.DeepEqual(result.PreOrder(), []string{"1", "2", "4", "5", "3", "6", "7"}) {
+			t.Errorf("Expected the shape to be kept, got %v", result.PreOrder())
+		}
+	})
+
+	t.Run("fold", func(t *testing.T) {
+		sum := FoldTree(func(x int, sums []int) int { return x + Sum(sums) }, tree)
+		if sum != 28 {
+			t.Errorf("Expected 28, got %d", sum)
+		}
+	})
+
+	t.Run("unfold", func(t *testing.T) {
+		// The binary tree of n with children 2n and 2n+1 up to 7.
+		result := UnfoldTree(func(n int) (int, []int) {
+			return n, Filter(func(c int) bool { return c <= 7 }, []int{2 * n, 2*n + 1})
+		}, 1)
+		if !reflect.DeepEqual(result.Levels(), [][]int{{1}, {2, 3}, {4, 5, 6, 7}}) {
+			t.Errorf("Expected a complete binary tree, got %v", result.Levels())
+		}
+	})
+
+	t.Run("prune", func(t *testing.T) {
+		pruned, ok := tree.Prune(func(x int) bool { return x != 2 && x != 7 }).Get()
+		if !ok || !reflect.DeepEqual(pruned.PreOrder(), []int{1, 3, 6}) {
+			t.Errorf("Expected [1 3 6], got %v", pruned.PreOrder())
+		}
+		if tree.Prune(func(x int) bool { return x > 1 }).IsSome() {
+			t.Errorf("Expected None when the root is pruned")
+		}
+		if tree.Size() != 7 {
+			t.Errorf("Expected the original tree to be unchanged")
+		}
+	})
+}
+
+func TestDrawTree(t *testing.T) {
+	expected := "1\n" +
+		"|\n" +
+		"+- 2\n" +
+		"|  |\n" +
+		"|  +- 4\n" +
+		"|  |\n" +
+		"|  `- 5\n" +
+		"|\n" +
+		"`- 3\n" +
+		"   |\n" +
+		"   `- 6\n" +
+		"      |\n" +
+		"      `- 7\n"
+	if result := DrawTree(sampleTree()); result != expected {
+		t.Errorf("Expected\n%s\ngot\n%s", expected, result)
+	}
+	if result := DrawTree(MkTree("a\nb", MkTree(""))); result != "a\nb\n|\n`- \n" {
+		t.Errorf("Expected multi-line and empty values to be drawn, got %q", result)
+	}
+}
+
+func TestTreeFromFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"src/main.go":         {},
+		"src/util/util.go":    {},
+		"src/util/strings.go": {},
+		"README.md":           {},
+	}
+	tree, err := TreeFromFS(fsys, ".")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	expected := MkTree(".",
+		MkTree("README.md"),
+		MkTree("src",
+			MkTree("main.go"),
+			MkTree("util", MkTree("strings.go"), MkTree("util.go")),
+		),
+	)
+	if !reflect.DeepEqual(tree, expected) {
+		t.Errorf("Expected\n%s\ngot\n%s", DrawTree(expected), DrawTree(tree))
+	}
+
+	t.Run("subdirectory root", func(t *testing.T) {
+		tree, err := TreeFromFS(fsys, "src/util")
+		if err != nil || !reflect.DeepEqual(tree.PreOrder(), []string{"src/util", "strings.go", "util.go"}) {
+			t.Errorf("Expected the util directory, got %v, %v", tree.PreOrder(), err)
+		}
+	})
+
+	t.Run("missing root", func(t *testing.T) {
+		if _, err := TreeFromFS(fsys, "missing"); !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("Expected fs.ErrNotExist, got %v", err)
+		}
+	})
+}
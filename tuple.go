@@ -0,0 +1,203 @@
+package functionalgo
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type (
+	Tuple[A any, B any] struct {
+		fst A
+		snd B
+	}
+	Tuple3[A any, B any, C any] struct {
+		fst A
+		snd B
+		thd C
+	}
+	Tuple4[A any, B any, C any, D any] struct {
+		fst A
+		snd B
+		thd C
+		fth D
+	}
+	Tuple5[A any, B any, C any, D any, E any] struct {
+		fst A
+		snd B
+		thd C
+		fth D
+		ffh E
+	}
+)
+
+func MkTuple[A any, B any](a A, b B) Tuple[A, B] {
+	return Tuple[A, B]{fst: a, snd: b}
+}
+
+func MkTuple3[A any, B any, C any](a A, b B, c C) Tuple3[A, B, C] {
+	return Tuple3[A, B, C]{fst: a, snd: b, thd: c}
+}
+
+func MkTuple4[A any, B any, C any, D any](a A, b B, c C, d D) Tuple4[A, B, C, D] {
+	return Tuple4[A, B, C, D]{fst: a, snd: b, thd: c, fth: d}
+}
+
+func MkTuple5[A any, B any, C any, D any, E any](a A, b B, c C, d D, e E) Tuple5[A, B, C, D, E] {
+	return Tuple5[A, B, C, D, E]{fst: a, snd: b, thd: c, fth: d, ffh: e}
+}
+
+func (t Tuple[A, B]) Unpack() (A, B) {
+	return t.fst, t.snd
+}
+
+func (t Tuple3[A, B, C]) Unpack() (A, B, C) {
+	return t.fst, t.snd, t.thd
+}
+
+func (t Tuple4[A, B, C, D]) Unpack() (A, B, C, D) {
+	return t.fst, t.snd, t.thd, t.fth
+}
+
+func (t Tuple5[A, B, C, D, E]) Unpack() (A, B, C, D, E) {
+	return t.fst, t.snd, t.thd, t.fth, t.ffh
+}
+
+func (t Tuple[A, B]) String() string {
+	return fmt.Sprintf("(%v, %v)", t.fst, t.snd)
+}
+
+func (t Tuple3[A, B, C]) String() string {
+	return fmt.Sprintf("(%v, %v, %v)", t.fst, t.snd, t.thd)
+}
+
+func (t Tuple4[A, B, C, D]) String() string {
+	return fmt.Sprintf("(%v, %v, %v, %v)", t.fst, t.snd, t.thd, t.fth)
+}
+
+func (t Tuple5[A, B, C, D, E]) String() string {
+	return fmt.Sprintf("(%v, %v, %v, %v, %v)", t.fst, t.snd, t.thd, t.fth, t.ffh)
+}
+
+// CompareTo orders tuples lexicographically, comparing components with Compare.
+func (t Tuple[A, B]) CompareTo(other Tuple[A, B]) ComparisonResult {
+	if c := Compare(t.fst, other.fst); c != EQ {
+		return c
+	}
+	return Compare(t.snd, other.snd)
+}
+
+func (t Tuple3[A, B, C]) CompareTo(other Tuple3[A, B, C]) ComparisonResult {
+	if c := MkTuple(t.fst, t.snd).CompareTo(MkTuple(other.fst, other.snd)); c != EQ {
+		return c
+	}
+	return Compare(t.thd, other.thd)
+}
+
+func (t Tuple4[A, B, C, D]) CompareTo(other Tuple4[A, B, C, D]) ComparisonResult {
+	if c := MkTuple3(t.fst, t.snd, t.thd).CompareTo(MkTuple3(other.fst, other.snd, other.thd)); c != EQ {
+		return c
+	}
+	return Compare(t.fth, other.fth)
+}
+
+func (t Tuple5[A, B, C, D, E]) CompareTo(other Tuple5[A, B, C, D, E]) ComparisonResult {
+	if c := MkTuple4(t.fst, t.snd, t.thd, t.fth).CompareTo(MkTuple4(other.fst, other.snd, other.thd, other.fth)); c != EQ {
+		return c
+	}
+	return Compare(t.ffh, other.ffh)
+}
+
+// MarshalJSON encodes a tuple as a two-element JSON array.
+func (t Tuple[A, B]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([2]any{t.fst, t.snd})
+}
+
+// UnmarshalJSON decodes a two-element JSON array. Like encoding/json does
+// for other types, it leaves t unchanged for null.
+func (t *Tuple[A, B]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw) != 2 {
+		return fmt.Errorf("tuple: expected JSON array of 2 elements, got %d", len(raw))
+	}
+	if err := json.Unmarshal(raw[0], &t.fst); err != nil {
+		return err
+	}
+	return json.Unmarshal(raw[1], &t.snd)
+}
+
+func Fst[A any, B any](t Tuple[A, B]) A {
+	return t.fst
+}
+
+func Snd[A any, B any](t Tuple[A, B]) B {
+	return t.snd
+}
+
+func Swap[A any, B any](t Tuple[A, B]) Tuple[B, A] {
+	return Tuple[B, A]{fst: t.snd, snd: t.fst}
+}
+
+func First[A any, B any, C any](fn func(A) C, t Tuple[A, B]) Tuple[C, B] {
+	return Tuple[C, B]{fst: fn(t.fst), snd: t.snd}
+}
+
+func Second[A any, B any, C any](fn func(B) C, t Tuple[A, B]) Tuple[A, C] {
+	return Tuple[A, C]{fst: t.fst, snd: fn(t.snd)}
+}
+
+func Bimap[A any, B any, C any, D any](fnA func(A) C, fnB func(B) D, t Tuple[A, B]) Tuple[C, D] {
+	return Tuple[C, D]{fst: fnA(t.fst), snd: fnB(t.snd)}
+}
+
+func Curry[A any, B any, C any](fn func(Tuple[A, B]) C) func(A, B) C {
+	return func(a A, b B) C {
+		return fn(Tuple[A, B]{fst: a, snd: b})
+	}
+}
+
+func Uncurry[A any, B any, C any](fn func(A, B) C) func(Tuple[A, B]) C {
+	return func(t Tuple[A, B]) C {
+		return fn(t.fst, t.snd)
+	}
+}
+
+func ZipWith3[A any, B any, C any, D any](fn func(A, B, C) D, srcA []A, srcB []B, srcC []C) (result []D) {
+	n := min(len(srcA), len(srcB), len(srcC))
+	if n == 0 {
+		return nil
+	}
+	result = make([]D, n)
+	for i := range n {
+		result[i] = fn(srcA[i], srcB[i], srcC[i])
+	}
+	return result
+}
+
+func Zip3[A any, B any, C any](srcA []A, srcB []B, srcC []C) (result []Tuple3[A, B, C]) {
+	return ZipWith3(MkTuple3[A, B, C], srcA, srcB, srcC)
+}
+
+func Unzip[A any, B any](src []Tuple[A, B]) ([]A, []B) {
+	as := make([]A, len(src))
+	bs := make([]B, len(src))
+	for i, t := range src {
+		as[i], bs[i] = t.fst, t.snd
+	}
+	return as, bs
+}
+
+func Unzip3[A any, B any, C any](src []Tuple3[A, B, C]) ([]A, []B, []C) {
+	as := make([]A, len(src))
+	bs := make([]B, len(src))
+	cs := make([]C, len(src))
+	for i, t := range src {
+		as[i], bs[i], cs[i] = t.fst, t.snd, t.thd
+	}
+	return as, bs, cs
+}
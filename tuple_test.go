@@ -0,0 +1,182 @@
+package functionalgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestMkTuple(t *testing.T) {
+	tuple := MkTuple("a", 1)
+	a, b := tuple.Unpack()
+	if a != "a" || b != 1 {
+		t.Errorf("Expected (a, 1), got (%v, %v)", a, b)
+	}
+
+	t3 := MkTuple3(1, "b", true)
+	x, y, z := t3.Unpack()
+	if x != 1 || y != "b" || !z {
+		t.Errorf("Expected (1, b, true), got (%v, %v, %v)", x, y, z)
+	}
+
+	_, _, _, d := MkTuple4(1, 2, 3, "d").Unpack()
+	if d != "d" {
+		t.Errorf("Expected d, got %v", d)
+	}
+
+	_, _, _, _, e := MkTuple5(1, 2, 3, 4, 5.5).Unpack()
+	if e != 5.5 {
+		t.Errorf("Expected 5.5, got %v", e)
+	}
+}
+
+func TestTupleString(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    fmt.Stringer
+		expected string
+	}{
+		{"pair", MkTuple("Alice", 30), "(Alice, 30)"},
+		{"triple", MkTuple3(1, 2, 3), "(1, 2, 3)"},
+		{"quadruple", MkTuple4(1, 2, 3, 4), "(1, 2, 3, 4)"},
+		{"quintuple", MkTuple5(1, 2, 3, 4, 5), "(1, 2, 3, 4, 5)"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if result := tc.input.String(); result != tc.expected {
+				t.Errorf("Expected %v, got %v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestTupleCompare(t *testing.T) {
+	if Compare(MkTuple(1, "b"), MkTuple(2, "a")) != LT {
+		t.Errorf("Expected first component to decide")
+	}
+	if Compare(MkTuple(1, "b"), MkTuple(1, "a")) != GT {
+		t.Errorf("Expected second component to break ties")
+	}
+	if Compare(MkTuple(1, "a"), MkTuple(1, "a")) != EQ {
+		t.Errorf("Expected equal tuples to compare EQ")
+	}
+	if Compare(MkTuple3(1, 1, 2), MkTuple3(1, 1, 3)) != LT {
+		t.Errorf("Expected third component to break ties")
+	}
+	if Compare(MkTuple5(1, 1, 1, 1, 9), MkTuple5(1, 1, 1, 1, 8)) != GT {
+		t.Errorf("Expected fifth component to break ties")
+	}
+	if result := Maximum([]Tuple[int, string]{MkTuple(1, "z"), MkTuple(3, "a"), MkTuple(2, "q")}); result != MkTuple(3, "a") {
+		t.Errorf("Expected (3, a), got %v", result)
+	}
+}
+
+func TestTupleJSON(t *testing.T) {
+	t.Run("marshal", func(t *testing.T) {
+		data, err := json.Marshal(MkTuple("a", 1))
+		if err != nil || string(data) != `["a",1]` {
+			t.Errorf("Expected [\"a\",1], got %s (%v)", data, err)
+		}
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var tuple Tuple[string, []int]
+		if err := json.Unmarshal([]byte(`["k",[1,2]]`), &tuple); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if Fst(tuple) != "k" || !reflect.DeepEqual(Snd(tuple), []int{1, 2}) {
+			t.Errorf("Expected (k, [1 2]), got %v", tuple)
+		}
+	})
+
+	t.Run("unmarshal wrong arity", func(t *testing.T) {
+		var tuple Tuple[int, int]
+		if err := json.Unmarshal([]byte(`[1,2,3]`), &tuple); err == nil {
+			t.Errorf("Expected error for 3-element array")
+		}
+	})
+
+	t.Run("unmarshal null", func(t *testing.T) {
+		tuple := MkTuple("k", 1)
+		if err := json.Unmarshal([]byte(`null`), &tuple); err != nil || tuple != MkTuple("k", 1) {
+			t.Errorf("Expected null to leave (k, 1) unchanged, got %v (%v)", tuple, err)
+		}
+		var doc struct {
+			Range *Tuple[int, int]
+			Span  Tuple[int, int]
+		}
+		if err := json.Unmarshal([]byte(`{"Range": null, "Span": null}`), &doc); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if doc.Range != nil || doc.Span != MkTuple(0, 0) {
+			t.Errorf("Expected null fields to stay empty, got %v %v", doc.Range, doc.Span)
+		}
+	})
+
+	t.Run("unmarshal wrong element type", func(t *testing.T) {
+		var tuple Tuple[int, int]
+		if err := json.Unmarshal([]byte(`[1,"x"]`), &tuple); err == nil {
+			t.Errorf("Expected error for mismatched element type")
+		}
+	})
+}
+
+func TestSwap(t *testing.T) {
+	if result := Swap(MkTuple(1, "a")); result != MkTuple("a", 1) {
+		t.Errorf("Expected (a, 1), got %v", result)
+	}
+}
+
+func TestBimap(t *testing.T) {
+	tuple := MkTuple(1, 2.5)
+	if result := First(strconv.Itoa, tuple); result != MkTuple("1", 2.5) {
+		t.Errorf("Expected (1, 2.5), got %v", result)
+	}
+	if result := Second(func(f float64) int { return int(f * 2) }, tuple); result != MkTuple(1, 5) {
+		t.Errorf("Expected (1, 5), got %v", result)
+	}
+	if result := Bimap(strconv.Itoa, func(f float64) bool { return f > 2 }, tuple); result != MkTuple("1", true) {
+		t.Errorf("Expected (1, true), got %v", result)
+	}
+}
+
+func TestCurry(t *testing.T) {
+	add := func(a, b int) int { return a + b }
+	if result := Map(Uncurry(add), Zip([]int{1, 2}, []int{10, 20})); !reflect.DeepEqual(result, []int{11, 22}) {
+		t.Errorf("Expected [11 22], got %v", result)
+	}
+	if result := Curry(Uncurry(add))(3, 4); result != 7 {
+		t.Errorf("Expected 7, got %v", result)
+	}
+}
+
+func TestZip3(t *testing.T) {
+	result := Zip3([]int{1, 2, 3}, []string{"a", "b"}, []bool{true, false, true})
+	expected := []Tuple3[int, string, bool]{MkTuple3(1, "a", true), MkTuple3(2, "b", false)}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	sums := ZipWith3(func(a, b, c int) int { return a + b + c }, []int{1, 2}, []int{10, 20}, []int{100, 200})
+	if !reflect.DeepEqual(sums, []int{111, 222}) {
+		t.Errorf("Expected [111 222], got %v", sums)
+	}
+
+	if result := Zip3([]int{}, []int{1}, []int{1}); result != nil {
+		t.Errorf("Expected nil, got %v", result)
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	as, bs := Unzip(Zip([]int{1, 2}, []string{"a", "b"}))
+	if !reflect.DeepEqual(as, []int{1, 2}) || !reflect.DeepEqual(bs, []string{"a", "b"}) {
+		t.Errorf("Expected [1 2] [a b], got %v %v", as, bs)
+	}
+
+	xs, ys, zs := Unzip3(Zip3([]int{1}, []string{"a"}, []bool{true}))
+	if !reflect.DeepEqual(xs, []int{1}) || !reflect.DeepEqual(ys, []string{"a"}) || !reflect.DeepEqual(zs, []bool{true}) {
+		t.Errorf("Expected [1] [a] [true], got %v %v %v", xs, ys, zs)
+	}
+}
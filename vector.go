@@ -0,0 +1,618 @@
+package functionalgo
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// Vector is a persistent indexed sequence stored as a relaxed radix balanced
+// (RRB) trie with 32-way branching. Get, Set, Append and Pop touch one path
+// of at most log32(n) nodes; every other node is shared with the original.
+// Slice and Concat are O(log n) too: they produce "relaxed" nodes whose
+// children are not all full, which carry a table of cumulative sizes for
+// indexing. The zero value is the empty vector.
+type Vector[T any] struct {
+	root  *vecNode[T]
+	shift uint
+	size  int
+}
+
+const (
+	vecBits  = 5
+	vecWidth = 1 << vecBits
+)
+
+// vecNode is a leaf holding values when its shift is 0, and an internal node
+// holding children otherwise. Each child of a node at shift s holds at most
+// 1<<s elements; sizes is nil when all children but the last are full, so
+// that indexing can use the radix alone.
+type vecNode[T any] struct {
+	children []*vecNode[T]
+	values   []T
+	sizes    []int
+	size     int
+	edit     *vecEdit
+}
+
+// vecEdit identifies the transient allowed to modify a node in place. It is
+// not zero-sized, so every token has a distinct address.
+type vecEdit struct{ _ byte }
+
+func VectorOf[T any](xs ...T) Vector[T] {
+	return VectorFromSlice(xs)
+}
+
+// vecMaxShift returns the shift of a packed trie holding size elements.
+func vecMaxShift(size int) uint {
+	var shift uint
+	for capacity := vecWidth; capacity < size; capacity <<= vecBits {
+		shift += vecBits
+	}
+	return shift
+}
+
+// VectorFromSlice builds a balanced vector from a copy of src.
+func VectorFromSlice[T any](src []T) Vector[T] {
+	if len(src) == 0 {
+		return Vector[T]{}
+	}
+	nodes := make([]*vecNode[T], 0, (len(src)+vecWidth-1)/vecWidth)
+	for chunk := range slices.Chunk(src, vecWidth) {
+		nodes = append(nodes, newVecLeaf(slices.Clone(chunk), nil))
+	}
+	var shift uint
+	for len(nodes) > 1 {
+		shift += vecBits
+		nodes = groupVecNodes(nodes, shift)
+	}
+	return Vector[T]{nodes[0], shift, len(src)}
+}
+
+func VectorFromSeq[T any](seq Seq[T]) Vector[T] {
+	return VectorFromSlice(Collect(seq))
+}
+
+func (v Vector[T]) Len() int {
+	return v.size
+}
+
+func (v Vector[T]) IsEmpty() bool {
+	return v.size == 0
+}
+
+func (v Vector[T]) Get(i int) T {
+	checkVecIndex(i, v.size)
+	return v.root.get(v.shift, i)
+}
+
+// Set returns a copy of v with the element at i replaced by x.
+func (v Vector[T]) Set(i int, x T) Vector[T] {
+	checkVecIndex(i, v.size)
+	return Vector[T]{v.root.set(v.shift, i, x, nil), v.shift, v.size}
+}
+
+func (v Vector[T]) Append(x T) Vector[T] {
+	root, shift := pushVec(v.root, v.shift, x, nil)
+	return Vector[T]{root, shift, v.size + 1}
+}
+
+// Pop returns v without its last element. It panics on an empty vector.
+func (v Vector[T]) Pop() Vector[T] {
+	if v.size == 0 {
+		panic("cannot pop from empty vector")
+	}
+	root, shift := popVec(v.root, v.shift, nil)
+	return Vector[T]{root, shift, v.size - 1}
+}
+
+// Slice returns the elements from index from up to, but excluding, to,
+// sharing all nodes outside the two boundary paths.
+func (v Vector[T]) Slice(from, to int) Vector[T] {
+	if from < 0 || to > v.size || from > to {
+		panic(fmt.Sprintf("slice bounds [%d:%d] out of range for vector of length %d", from, to, v.size))
+	}
+	if from == to {
+		return Vector[T]{}
+	}
+	root := v.root
+	if to < v.size {
+		root = root.take(v.shift, to)
+	}
+	if from > 0 {
+		root = root.drop(v.shift, from)
+	}
+	root, shift := shortenVec(collapseVec(root, v.shift))
+	return Vector[T]{root, shift, to - from}
+}
+
+// Concat returns the elements of v followed by those of other. Only the
+// nodes along the seam between the two are rebuilt.
+func (v Vector[T]) Concat(other Vector[T]) Vector[T] {
+	if v.size == 0 {
+		return other
+	}
+	if other.size == 0 {
+		return v
+	}
+	nodes, shift := concatVecNodes(v.root, v.shift, other.root, other.shift)
+	for len(nodes) > 1 {
+		shift += vecBits
+		nodes = rebalanceVec(nodes, shift)
+	}
+	root, shift := collapseVec(nodes[0], shift)
+	return Vector[T]{root, shift, v.size + other.size}
+}
+
+func (v Vector[T]) Seq() Seq[T] {
+	return func(yield func(T) bool) {
+		if v.root != nil {
+			v.root.each(v.shift, yield)
+		}
+	}
+}
+
+func (v Vector[T]) ToSlice() []T {
+	return AppendSeq(make([]T, 0, v.size), v.Seq())
+}
+
+func (v Vector[T]) String() string {
+	var sb strings.Builder
+	sb.WriteByte('[')
+	for i, x := range Enumerate(v.Seq()) {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		fmt.Fprint(&sb, x)
+	}
+	sb.WriteByte(']')
+	return sb.String()
+}
+
+// CompareTo compares vectors lexicographically, a shorter prefix sorting
+// first.
+func (v Vector[T]) CompareTo(other Vector[T]) ComparisonResult {
+	next, stop := iter.Pull(iter.Seq[T](other.Seq()))
+	defer stop()
+	for x := range v.Seq() {
+		y, ok := next()
+		if !ok {
+			return GT
+		}
+		if c := Compare(x, y); c != EQ {
+			return c
+		}
+	}
+	if _, ok := next(); ok {
+		return LT
+	}
+	return EQ
+}
+
+// Transient returns a mutable copy of v for batches of updates. It shares
+// all nodes with v and copies each one only the first time it is modified.
+func (v Vector[T]) Transient() *TransientVector[T] {
+	return &TransientVector[T]{v.root, v.shift, v.size, new(vecEdit)}
+}
+
+// TransientVector is a Vector that is updated in place. Nodes it has already
+// copied are modified directly, so a batch of n updates costs far less than n
+// persistent updates. It must not be used after Persistent.
+type TransientVector[T any] struct {
+	root  *vecNode[T]
+	shift uint
+	size  int
+	edit  *vecEdit
+}
+
+func (t *TransientVector[T]) Len() int {
+	return t.size
+}
+
+func (t *TransientVector[T]) Get(i int) T {
+	t.ensureEditable()
+	checkVecIndex(i, t.size)
+	return t.root.get(t.shift, i)
+}
+
+func (t *TransientVector[T]) Set(i int, x T) {
+	t.ensureEditable()
+	checkVecIndex(i, t.size)
+	t.root = t.root.set(t.shift, i, x, t.edit)
+}
+
+func (t *TransientVector[T]) Append(x T) {
+	t.ensureEditable()
+	t.root, t.shift = pushVec(t.root, t.shift, x, t.edit)
+	t.size++
+}
+
+func (t *TransientVector[T]) Pop() {
+	t.ensureEditable()
+	if t.size == 0 {
+		panic("cannot pop from empty vector")
+	}
+	t.root, t.shift = popVec(t.root, t.shift, t.edit)
+	t.size--
+}
+
+// Persistent freezes the transient and returns its contents as a Vector.
+func (t *TransientVector[T]) Persistent() Vector[T] {
+	t.ensureEditable()
+	t.edit = nil
+	return Vector[T]{t.root, t.shift, t.size}
+}
+
+func (t *TransientVector[T]) ensureEditable() {
+	if t.edit == nil {
+		panic("transient vector used after Persistent")
+	}
+}
+
+func MapVector[A any, B any](fn func(A) B, v Vector[A]) Vector[B] {
+	if v.root == nil {
+		return Vector[B]{}
+	}
+	return Vector[B]{mapVecNode(fn, v.root, v.shift), v.shift, v.size}
+}
+
+func FilterVector[A any](fn func(A) bool, v Vector[A]) Vector[A] {
+	return VectorFromSeq(FilterSeq(fn, v.Seq()))
+}
+
+func FoldlVector[A any, B any](fn func(B, A) B, acc B, v Vector[A]) B {
+	return FoldlSeq(fn, acc, v.Seq())
+}
+
+func checkVecIndex(i, size int) {
+	if i < 0 || i >= size {
+		panic(fmt.Sprintf("index %d out of range for vector of length %d", i, size))
+	}
+}
+
+func newVecLeaf[T any](values []T, edit *vecEdit) *vecNode[T] {
+	return &vecNode[T]{values: values, size: len(values), edit: edit}
+}
+
+func newVecInternal[T any](children []*vecNode[T], shift uint, edit *vecEdit) *vecNode[T] {
+	n := &vecNode[T]{children: children, edit: edit}
+	n.fixup(shift)
+	return n
+}
+
+// newVecPath wraps a single-element leaf in empty parents up to shift.
+func newVecPath[T any](shift uint, x T, edit *vecEdit) *vecNode[T] {
+	n := newVecLeaf([]T{x}, edit)
+	for s := uint(vecBits); s <= shift; s += vecBits {
+		n = newVecInternal([]*vecNode[T]{n}, s, edit)
+	}
+	return n
+}
+
+// groupVecNodes puts nodes into parents at shift, 32 at a time.
+func groupVecNodes[T any](nodes []*vecNode[T], shift uint) []*vecNode[T] {
+	parents := make([]*vecNode[T], 0, (len(nodes)+vecWidth-1)/vecWidth)
+	for chunk := range slices.Chunk(nodes, vecWidth) {
+		parents = append(parents, newVecInternal(slices.Clone(chunk), shift, nil))
+	}
+	return parents
+}
+
+// collapseVec removes parents with a single child from the top of the tree.
+func collapseVec[T any](root *vecNode[T], shift uint) (*vecNode[T], uint) {
+	for shift > 0 && len(root.children) == 1 {
+		root, shift = root.children[0], shift-vecBits
+	}
+	return root, shift
+}
+
+// shortenVec joins the children of a root that is taller than a packed trie
+// of the same size. Slicing across a subtree boundary leaves such a root,
+// with a sparse child on each side of the boundary; concatenating them
+// rebalances only the seam between them.
+func shortenVec[T any](root *vecNode[T], shift uint) (*vecNode[T], uint) {
+	if shift <= vecMaxShift(root.size) {
+		return root, shift
+	}
+	var v Vector[T]
+	for _, c := range root.children {
+		child, childShift := collapseVec(c, shift-vecBits)
+		v = v.Concat(Vector[T]{child, childShift, c.size})
+	}
+	return v.root, v.shift
+}
+
+func pushVec[T any](root *vecNode[T], shift uint, x T, edit *vecEdit) (*vecNode[T], uint) {
+	if root == nil {
+		return newVecLeaf([]T{x}, edit), 0
+	}
+	if n := root.push(shift, x, edit); n != nil {
+		return n, shift
+	}
+	children := []*vecNode[T]{root, newVecPath(shift, x, edit)}
+	return newVecInternal(children, shift+vecBits, edit), shift + vecBits
+}
+
+func popVec[T any](root *vecNode[T], shift uint, edit *vecEdit) (*vecNode[T], uint) {
+	root = root.pop(shift, edit)
+	if root == nil {
+		return nil, 0
+	}
+	return collapseVec(root, shift)
+}
+
+// editable returns n itself if it belongs to edit, or a copy that does.
+func (n *vecNode[T]) editable(edit *vecEdit) *vecNode[T] {
+	if edit != nil && n.edit == edit {
+		return n
+	}
+	return &vecNode[T]{
+		children: slices.Clone(n.children),
+		values:   slices.Clone(n.values),
+		sizes:    n.sizes,
+		size:     n.size,
+		edit:     edit,
+	}
+}
+
+// fixup recomputes the size of an internal node and whether it needs a size
+// table. The table is always replaced, never updated, so copies may share it.
+func (n *vecNode[T]) fixup(shift uint) {
+	balanced := true
+	n.size = 0
+	for i, c := range n.children {
+		n.size += c.size
+		if i < len(n.children)-1 && c.size != 1<<shift || c.sizes != nil {
+			balanced = false
+		}
+	}
+	n.sizes = nil
+	if balanced {
+		return
+	}
+	n.sizes = make([]int, len(n.children))
+	total := 0
+	for i, c := range n.children {
+		total += c.size
+		n.sizes[i] = total
+	}
+}
+
+// child returns the index of the child holding element i and the position
+// of the element within that child.
+func (n *vecNode[T]) child(shift uint, i int) (int, int) {
+	idx := i >> shift
+	if n.sizes == nil {
+		return idx, i - idx<<shift
+	}
+	for n.sizes[idx] <= i {
+		idx++
+	}
+	if idx > 0 {
+		i -= n.sizes[idx-1]
+	}
+	return idx, i
+}
+
+func (n *vecNode[T]) get(shift uint, i int) T {
+	for ; shift > 0; shift -= vecBits {
+		var idx int
+		idx, i = n.child(shift, i)
+		n = n.children[idx]
+	}
+	return n.values[i]
+}
+
+func (n *vecNode[T]) set(shift uint, i int, x T, edit *vecEdit) *vecNode[T] {
+	n = n.editable(edit)
+	if shift == 0 {
+		n.values[i] = x
+		return n
+	}
+	idx, rem := n.child(shift, i)
+	n.children[idx] = n.children[idx].set(shift-vecBits, rem, x, edit)
+	return n
+}
+
+// push appends x to the subtree, returning nil if it has no room left.
+func (n *vecNode[T]) push(shift uint, x T, edit *vecEdit) *vecNode[T] {
+	if shift == 0 {
+		if len(n.values) == vecWidth {
+			return nil
+		}
+		n = n.editable(edit)
+		n.values = append(n.values, x)
+		n.size++
+		return n
+	}
+	last := len(n.children) - 1
+	if c := n.children[last].push(shift-vecBits, x, edit); c != nil {
+		n = n.editable(edit)
+		n.children[last] = c
+	} else if len(n.children) < vecWidth {
+		n = n.editable(edit)
+		n.children = append(n.children, newVecPath(shift-vecBits, x, edit))
+	} else {
+		return nil
+	}
+	n.fixup(shift)
+	return n
+}
+
+// pop removes the last element, returning nil if the subtree becomes empty.
+func (n *vecNode[T]) pop(shift uint, edit *vecEdit) *vecNode[T] {
+	if n.size == 1 {
+		return nil
+	}
+	n = n.editable(edit)
+	if shift == 0 {
+		var zero T
+		n.values[len(n.values)-1] = zero
+		n.values = n.values[:len(n.values)-1]
+		n.size--
+		return n
+	}
+	last := len(n.children) - 1
+	if c := n.children[last].pop(shift-vecBits, edit); c != nil {
+		n.children[last] = c
+	} else {
+		n.children[last] = nil
+		n.children = n.children[:last]
+	}
+	n.fixup(shift)
+	return n
+}
+
+// take returns the subtree holding the first k elements of n, 0 < k.
+func (n *vecNode[T]) take(shift uint, k int) *vecNode[T] {
+	if k == n.size {
+		return n
+	}
+	if shift == 0 {
+		return newVecLeaf(slices.Clone(n.values[:k]), nil)
+	}
+	idx, rem := n.child(shift, k-1)
+	children := slices.Clone(n.children[:idx+1])
+	children[idx] = children[idx].take(shift-vecBits, rem+1)
+	return newVecInternal(children, shift, nil)
+}
+
+// drop returns the subtree holding all but the first k elements of n,
+// k < n.size.
+func (n *vecNode[T]) drop(shift uint, k int) *vecNode[T] {
+	if k == 0 {
+		return n
+	}
+	if shift == 0 {
+		return newVecLeaf(slices.Clone(n.values[k:]), nil)
+	}
+	idx, rem := n.child(shift, k)
+	children := slices.Clone(n.children[idx:])
+	children[0] = children[0].drop(shift-vecBits, rem)
+	return newVecInternal(children, shift, nil)
+}
+
+func (n *vecNode[T]) each(shift uint, yield func(T) bool) bool {
+	if shift == 0 {
+		for _, x := range n.values {
+			if !yield(x) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, c := range n.children {
+		if !c.each(shift-vecBits, yield) {
+			return false
+		}
+	}
+	return true
+}
+
+func mapVecNode[A any, B any](fn func(A) B, n *vecNode[A], shift uint) *vecNode[B] {
+	if shift == 0 {
+		return newVecLeaf(Map(fn, n.values), nil)
+	}
+	children := make([]*vecNode[B], len(n.children))
+	for i, c := range n.children {
+		children[i] = mapVecNode(fn, c, shift-vecBits)
+	}
+	return &vecNode[B]{children: children, sizes: n.sizes, size: n.size}
+}
+
+// concatVecNodes joins two subtrees, returning one or more nodes at the
+// larger of their shifts. It descends along the seam, where the rightmost
+// nodes of l meet the leftmost nodes of r, and rebalances the nodes there on
+// the way back up.
+func concatVecNodes[T any](l *vecNode[T], ls uint, r *vecNode[T], rs uint) ([]*vecNode[T], uint) {
+	switch {
+	case ls > rs:
+		last := len(l.children) - 1
+		mid, _ := concatVecNodes(l.children[last], ls-vecBits, r, rs)
+		return rebalanceVec(slices.Concat(l.children[:last], mid), ls), ls
+	case ls < rs:
+		mid, _ := concatVecNodes(l, ls, r.children[0], rs-vecBits)
+		return rebalanceVec(slices.Concat(mid, r.children[1:]), rs), rs
+	case ls == 0:
+		return []*vecNode[T]{l, r}, 0
+	default:
+		last := len(l.children) - 1
+		mid, _ := concatVecNodes(l.children[last], ls-vecBits, r.children[0], rs-vecBits)
+		return rebalanceVec(slices.Concat(l.children[:last], mid, r.children[1:]), ls), ls
+	}
+}
+
+// rebalanceVec redistributes the contents of children, which sit at
+// shift-vecBits, following planVec, and groups the result into one or two
+// nodes at shift.
+func rebalanceVec[T any](children []*vecNode[T], shift uint) []*vecNode[T] {
+	return groupVecNodes(executeVecPlan(children, planVec(children), shift-vecBits), shift)
+}
+
+// planVec returns how many slots (values or children) each node should hold
+// after rebalancing. This is the RRB concatenation plan with no extra nodes
+// allowed: while there are more nodes than the slots need, the first node
+// that is not full is merged into its right neighbours. Nodes are only
+// touched from there on, so a small node at the seam stays at the seam,
+// where the next concatenation can fill it, instead of being carried away
+// from it.
+func planVec[T any](nodes []*vecNode[T]) []int {
+	plan := make([]int, len(nodes))
+	total := 0
+	for i, n := range nodes {
+		plan[i] = len(n.values) + len(n.children)
+		total += plan[i]
+	}
+	optimal := (total + vecWidth - 1) / vecWidth
+	for i := 0; len(plan) > optimal; {
+		for plan[i] == vecWidth {
+			i++
+		}
+		for remaining := plan[i]; remaining > 0; i++ {
+			filled := min(remaining+plan[i+1], vecWidth)
+			plan[i] = filled
+			remaining += plan[i+1] - filled
+		}
+		plan = slices.Delete(plan, i, i+1)
+		i--
+	}
+	return plan
+}
+
+// executeVecPlan copies the slots of nodes, which sit at shift, into new
+// nodes sized by plan. Nodes whose slots would not change are reused.
+func executeVecPlan[T any](nodes []*vecNode[T], plan []int, shift uint) []*vecNode[T] {
+	result := make([]*vecNode[T], 0, len(plan))
+	var values []T
+	var children []*vecNode[T]
+	i, offset := 0, 0
+	for _, want := range plan {
+		n := nodes[i]
+		if offset == 0 && len(n.values)+len(n.children) == want {
+			result = append(result, n)
+			i++
+			continue
+		}
+		values, children = nil, nil
+		for have := 0; have < want; {
+			n := nodes[i]
+			take := min(want-have, len(n.values)+len(n.children)-offset)
+			if shift == 0 {
+				values = append(values, n.values[offset:offset+take]...)
+			} else {
+				children = append(children, n.children[offset:offset+take]...)
+			}
+			have += take
+			if offset += take; offset == len(n.values)+len(n.children) {
+				i, offset = i+1, 0
+			}
+		}
+		if shift == 0 {
+			result = append(result, newVecLeaf(values, nil))
+		} else {
+			result = append(result, newVecInternal(children, shift, nil))
+		}
+	}
+	return result
+}
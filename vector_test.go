@@ -0,0 +1,290 @@
+package functionalgo
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// checkVector verifies the size tables and the balanced-node invariant of v,
+// and that it is at most one level taller than a packed trie.
+func checkVector[T any](t *testing.T, v Vector[T]) {
+	t.Helper()
+	var check func(n *vecNode[T], shift uint) int
+	check = func(n *vecNode[T], shift uint) int {
+		if shift == 0 {
+			if len(n.values) == 0 || len(n.values) > vecWidth || n.size != len(n.values) {
+				t.Fatalf("Invalid leaf with %d values and size %d", len(n.values), n.size)
+			}
+			return n.size
+		}
+		if len(n.children) == 0 || len(n.children) > vecWidth {
+			t.Fatalf("Invalid node with %d children", len(n.children))
+		}
+		total := 0
+		for i, c := range n.children {
+			total += check(c, shift-vecBits)
+			if n.sizes != nil && n.sizes[i] != total {
+				t.Fatalf("Size table %v does not match children", n.sizes)
+			}
+			if n.sizes == nil && i < len(n.children)-1 && c.size != 1<<shift {
+				t.Fatalf("Balanced node has a partial child of size %d", c.size)
+			}
+		}
+		if total != n.size {
+			t.Fatalf("Node size %d does not match its children (%d)", n.size, total)
+		}
+		return total
+	}
+	if v.root == nil {
+		if v.size != 0 {
+			t.Fatalf("Empty root with size %d", v.size)
+		}
+		return
+	}
+	if check(v.root, v.shift) != v.size {
+		t.Fatalf("Vector size %d does not match its root", v.size)
+	}
+	if v.shift > vecMaxShift(v.size)+vecBits {
+		t.Fatalf("Vector of size %d has shift %d, a packed trie would have %d", v.size, v.shift, vecMaxShift(v.size))
+	}
+}
+
+func TestVectorBasics(t *testing.T) {
+	var empty Vector[int]
+	if empty.Len() != 0 || !empty.IsEmpty() || empty.String() != "[]" {
+		t.Errorf("Expected the zero value to be an empty vector")
+	}
+
+	v := VectorOf(1, 2, 3)
+	if v.Get(1) != 2 || v.Len() != 3 || v.String() != "[1, 2, 3]" {
+		t.Errorf("Expected [1, 2, 3], got %v", v)
+	}
+	w := v.Set(1, 20).Append(4)
+	if !reflect.DeepEqual(w.ToSlice(), []int{1, 20, 3, 4}) || !reflect.DeepEqual(v.ToSlice(), []int{1, 2, 3}) {
+		t.Errorf("Expected updates to leave the original unchanged, got %v and %v", v, w)
+	}
+	if result := w.Pop().Pop().ToSlice(); !reflect.DeepEqual(result, []int{1, 20}) {
+		t.Errorf("Expected [1 20], got %v", result)
+	}
+
+	t.Run("out of range", func(t *testing.T) {
+		expectPanic(t, "Get(-1)", func() { v.Get(-1) })
+		expectPanic(t, "Get(3)", func() { v.Get(3) })
+		expectPanic(t, "Set(3)", func() { v.Set(3, 0) })
+		expectPanic(t, "Pop on empty", func() { empty.Pop() })
+		expectPanic(t, "Slice(2, 1)", func() { v.Slice(2, 1) })
+	})
+}
+
+func TestVectorLarge(t *testing.T) {
+	src := Range(0, 100_000, 1)
+	v := VectorFromSlice(src)
+	checkVector(t, v)
+	if !reflect.DeepEqual(v.ToSlice(), src) {
+		t.Fatalf("Expected the vector to round-trip through a slice")
+	}
+
+	var appended Vector[int]
+	for _, x := range src {
+		appended = appended.Append(x)
+	}
+	checkVector(t, appended)
+	if appended.Get(54_321) != 54_321 || appended.shift != v.shift {
+		t.Errorf("Expected appends to build the same balanced tree")
+	}
+
+	for appended.Len() > 40_000 {
+		appended = appended.Pop()
+	}
+	checkVector(t, appended)
+	if !reflect.DeepEqual(appended.ToSlice(), src[:40_000]) {
+		t.Errorf("Expected popping to leave the first 40000 elements")
+	}
+}
+
+func TestVectorSharing(t *testing.T) {
+	v := VectorFromSlice(Range(0, 2048, 1))
+	w := v.Set(0, -1)
+	if v.root.children[1] != w.root.children[1] {
+		t.Errorf("Expected Set to share untouched subtrees")
+	}
+	if v.Get(0) != 0 || w.Get(0) != -1 {
+		t.Errorf("Expected Set to leave the original unchanged")
+	}
+}
+
+func TestVectorSliceConcat(t *testing.T) {
+	src := Range(0, 5000, 1)
+	v := VectorFromSlice(src)
+
+	s := v.Slice(1000, 4000)
+	checkVector(t, s)
+	if !reflect.DeepEqual(s.ToSlice(), src[1000:4000]) {
+		t.Errorf("Expected Slice to match the slice expression")
+	}
+
+	c := s.Concat(v)
+	checkVector(t, c)
+	if !reflect.DeepEqual(c.ToSlice(), slices.Concat(src[1000:4000], src)) {
+		t.Errorf("Expected Concat to append the second vector")
+	}
+	if c.Get(2999) != 3999 || c.Get(3000) != 0 {
+		t.Errorf("Expected indexing to work across the seam")
+	}
+
+	t.Run("slicing across a subtree boundary does not copy", func(t *testing.T) {
+		dense := VectorFromSlice(Range(0, 100000, 1))
+		crossing := testing.AllocsPerRun(10, func() { dense.Slice(70000, 100000) })
+		inside := testing.AllocsPerRun(10, func() { dense.Slice(33000, 63000) })
+		if crossing > 2*inside {
+			t.Errorf("Expected a boundary-crossing slice to allocate about as much as %v, got %v", inside, crossing)
+		}
+		small := dense.Slice(1020, 1030)
+		checkVector(t, small)
+		if small.shift != 0 || !reflect.DeepEqual(small.ToSlice(), Range(1020, 1030, 1)) {
+			t.Errorf("Expected a single leaf holding 1020..1029, got shift %d and %v", small.shift, small)
+		}
+	})
+
+	t.Run("concatenating many small vectors stays shallow", func(t *testing.T) {
+		var acc Vector[int]
+		for i := range 2000 {
+			acc = acc.Concat(VectorOf(i, i, i))
+		}
+		checkVector(t, acc)
+		if acc.Len() != 6000 || acc.Get(5999) != 1999 {
+			t.Errorf("Expected 6000 elements ending in 1999, got %d", acc.Len())
+		}
+		if acc.shift > 2*vecBits {
+			t.Errorf("Expected a tree of height at most 3, got shift %d", acc.shift)
+		}
+	})
+
+	t.Run("prepending many small vectors stays shallow", func(t *testing.T) {
+		var acc Vector[int]
+		for i := range 3000 {
+			acc = VectorOf(i, i, i).Concat(acc)
+			if i%100 == 0 {
+				checkVector(t, acc)
+			}
+		}
+		checkVector(t, acc)
+		if acc.Len() != 9000 || acc.Get(0) != 2999 || acc.Get(8999) != 0 {
+			t.Errorf("Expected 9000 elements from 2999 down to 0, got %d", acc.Len())
+		}
+		if acc.shift > 2*vecBits {
+			t.Errorf("Expected a tree of height at most 3, got shift %d", acc.shift)
+		}
+	})
+
+	t.Run("alternating slices and concats stay shallow", func(t *testing.T) {
+		r := rand.New(rand.NewPCG(7, 11))
+		var acc Vector[int]
+		var want []int
+		for i := range 2000 {
+			piece := Range(i*10, i*10+r.IntN(40)+1, 1)
+			at := r.IntN(len(want) + 1)
+			acc = acc.Slice(0, at).Concat(VectorFromSlice(piece)).Concat(acc.Slice(at, acc.Len()))
+			want = slices.Insert(want, at, piece...)
+			checkVector(t, acc)
+		}
+		if !reflect.DeepEqual(acc.ToSlice(), want) {
+			t.Errorf("Expected inserting through Slice and Concat to match slices.Insert")
+		}
+	})
+}
+
+func TestTransientVector(t *testing.T) {
+	v := VectorOf(1, 2, 3)
+	tv := v.Transient()
+	for i := range 1000 {
+		tv.Append(i)
+	}
+	tv.Set(0, 100)
+	tv.Pop()
+	if tv.Len() != 1002 || tv.Get(0) != 100 {
+		t.Errorf("Expected 1002 elements starting with 100, got %d", tv.Len())
+	}
+	w := tv.Persistent()
+	checkVector(t, w)
+	if !reflect.DeepEqual(v.ToSlice(), []int{1, 2, 3}) {
+		t.Errorf("Expected the source vector to be unchanged, got %v", v)
+	}
+	if w.Get(1001) != 998 {
+		t.Errorf("Expected 998, got %d", w.Get(1001))
+	}
+	expectPanic(t, "Append after Persistent", func() { tv.Append(0) })
+
+	t.Run("frozen vector is not modified by a new transient", func(t *testing.T) {
+		tv := w.Transient()
+		tv.Set(5, -5)
+		if w.Get(5) == -5 {
+			t.Errorf("Expected the persistent vector to be unchanged")
+		}
+	})
+}
+
+func TestVectorCombinators(t *testing.T) {
+	v := VectorFromSlice(Range(0, 100, 1))
+	if result := MapVector(func(x int) int { return x * 2 }, v); result.Get(99) != 198 || result.Len() != 100 {
+		t.Errorf("MapVector: unexpected result %v", result)
+	}
+	if result := FilterVector(func(x int) bool { return x%10 == 0 }, v).ToSlice(); !reflect.DeepEqual(result, Range(0, 100, 10)) {
+		t.Errorf("FilterVector: got %v", result)
+	}
+	if result := FoldlVector(func(acc, x int) int { return acc + x }, 0, v); result != 4950 {
+		t.Errorf("FoldlVector: expected 4950, got %d", result)
+	}
+	if Compare(VectorOf(1, 2), VectorOf(1, 2)) != EQ || Compare(VectorOf(1), VectorOf(1, 0)) != LT {
+		t.Errorf("Expected vectors to compare by contents")
+	}
+	if Compare(VectorOf(1, 2, 3).Slice(1, 3), VectorOf(0, 2, 3).Slice(1, 3)) != EQ {
+		t.Errorf("Expected vectors with different shapes to compare by contents")
+	}
+}
+
+func TestVectorRandomOps(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	var v Vector[int]
+	var model []int
+	for step := range 3000 {
+		switch op := rng.IntN(10); {
+		case op < 4:
+			v, model = v.Append(step), append(model, step)
+		case op < 5 && len(model) > 0:
+			v, model = v.Pop(), model[:len(model)-1]
+		case op < 7 && len(model) > 0:
+			i := rng.IntN(len(model))
+			v = v.Set(i, -step)
+			model = slices.Clone(model)
+			model[i] = -step
+		case op < 8:
+			from := rng.IntN(len(model) + 1)
+			to := from + rng.IntN(len(model)-from+1)
+			v, model = v.Slice(from, to), slices.Clone(model[from:to])
+		default:
+			n := rng.IntN(200)
+			other := VectorFromSlice(Range(0, n, 1))
+			if rng.IntN(2) == 0 {
+				v, model = v.Concat(other), slices.Concat(model, Range(0, n, 1))
+			} else {
+				v, model = other.Concat(v), slices.Concat(Range(0, n, 1), model)
+			}
+		}
+		checkVector(t, v)
+		if v.Len() != len(model) {
+			t.Fatalf("Step %d: expected length %d, got %d", step, len(model), v.Len())
+		}
+		if len(model) > 0 {
+			i := rng.IntN(len(model))
+			if v.Get(i) != model[i] {
+				t.Fatalf("Step %d: expected element %d to be %d, got %d", step, i, model[i], v.Get(i))
+			}
+		}
+	}
+	if !slices.Equal(v.ToSlice(), model) {
+		t.Errorf("Expected the vector to match the slice model")
+	}
+}
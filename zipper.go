@@ -0,0 +1,211 @@
+package functionalgo
+
+import "slices"
+
+// ListZipper is a non-empty List with a focus: the elements before the
+// focus (nearest first), the focused element, and the elements after it.
+// Moving the focus and editing at it are O(1) and share the rest of the
+// list; ToList rebuilds the whole list in O(Index).
+type ListZipper[T any] struct {
+	left  List[T]
+	focus T
+	right List[T]
+}
+
+// ListZipperFromList focuses the first element of l, or returns None if l is
+// empty.
+func ListZipperFromList[T any](l List[T]) Option[ListZipper[T]] {
+	if l.IsEmpty() {
+		return None[ListZipper[T]]()
+	}
+	return Some(ListZipper[T]{focus: l.Head(), right: l.Tail()})
+}
+
+func ListZipperFromSlice[T any](src []T) Option[ListZipper[T]] {
+	return ListZipperFromList(ListFromSlice(src))
+}
+
+func (z ListZipper[T]) Focus() T {
+	return z.focus
+}
+
+// Index is the position of the focus in the list.
+func (z ListZipper[T]) Index() int {
+	return z.left.Len()
+}
+
+func (z ListZipper[T]) Len() int {
+	return z.left.Len() + 1 + z.right.Len()
+}
+
+// Left moves the focus one element towards the front, or returns None at
+// the first element.
+func (z ListZipper[T]) Left() Option[ListZipper[T]] {
+	if z.left.IsEmpty() {
+		return None[ListZipper[T]]()
+	}
+	return Some(ListZipper[T]{z.left.Tail(), z.left.Head(), Cons(z.focus, z.right)})
+}
+
+// Right moves the focus one element towards the back, or returns None at
+// the last element.
+func (z ListZipper[T]) Right() Option[ListZipper[T]] {
+	if z.right.IsEmpty() {
+		return None[ListZipper[T]]()
+	}
+	return Some(ListZipper[T]{Cons(z.focus, z.left), z.right.Head(), z.right.Tail()})
+}
+
+func (z ListZipper[T]) Set(x T) ListZipper[T] {
+	return ListZipper[T]{z.left, x, z.right}
+}
+
+func (z ListZipper[T]) Modify(fn func(T) T) ListZipper[T] {
+	return z.Set(fn(z.focus))
+}
+
+// Insert puts x at the focus, shifting the focused element one place back.
+func (z ListZipper[T]) Insert(x T) ListZipper[T] {
+	return ListZipper[T]{z.left, x, Cons(z.focus, z.right)}
+}
+
+// Delete removes the focused element and focuses the next one, or the
+// previous one at the end of the list. It returns None if the focus was the
+// only element.
+func (z ListZipper[T]) Delete() Option[ListZipper[T]] {
+	switch {
+	case !z.right.IsEmpty():
+		return Some(ListZipper[T]{z.left, z.right.Head(), z.right.Tail()})
+	case !z.left.IsEmpty():
+		return Some(ListZipper[T]{z.left.Tail(), z.left.Head(), z.right})
+	}
+	return None[ListZipper[T]]()
+}
+
+// ToList returns the whole list, sharing the nodes after the focus.
+func (z ListZipper[T]) ToList() List[T] {
+	return FoldlList(func(acc List[T], x T) List[T] { return Cons(x, acc) }, Cons(z.focus, z.right), z.left)
+}
+
+func (z ListZipper[T]) ToSlice() []T {
+	return z.ToList().ToSlice()
+}
+
+// TreeZipper is a Tree with a focused subtree and the path back to the
+// root. Moving down, sideways or up is O(1) in the size of the tree; the
+// only copying is of the parent's children, once, when leaving a focus that
+// was edited. Moves return None when there is nowhere to go.
+type TreeZipper[T any] struct {
+	focus  Tree[T]
+	edited bool
+	crumbs List[treeCrumb[T]]
+}
+
+// treeCrumb records a parent's value, its children and the index of the
+// focus among them. children is shared with the original tree until an
+// edited sibling is written back, and edited is set once the parent differs
+// from the tree it was taken from.
+type treeCrumb[T any] struct {
+	value    T
+	children []Tree[T]
+	index    int
+	edited   bool
+}
+
+// TreeZipperFromTree focuses the root of t.
+func TreeZipperFromTree[T any](t Tree[T]) TreeZipper[T] {
+	return TreeZipper[T]{focus: t}
+}
+
+func (z TreeZipper[T]) Focus() Tree[T] {
+	return z.focus
+}
+
+func (z TreeZipper[T]) Value() T {
+	return z.focus.Value
+}
+
+func (z TreeZipper[T]) IsRoot() bool {
+	return z.crumbs.IsEmpty()
+}
+
+// Depth is the number of steps from the root to the focus.
+func (z TreeZipper[T]) Depth() int {
+	return z.crumbs.Len()
+}
+
+// Down focuses the i-th child of the focus, counting from zero.
+func (z TreeZipper[T]) Down(i int) Option[TreeZipper[T]] {
+	children := z.focus.Children
+	if i < 0 || i >= len(children) {
+		return None[TreeZipper[T]]()
+	}
+	crumb := treeCrumb[T]{z.focus.Value, children, i, z.edited}
+	return Some(TreeZipper[T]{children[i], false, Cons(crumb, z.crumbs)})
+}
+
+// Up focuses the parent, rebuilding it with the edited focus.
+func (z TreeZipper[T]) Up() Option[TreeZipper[T]] {
+	if z.crumbs.IsEmpty() {
+		return None[TreeZipper[T]]()
+	}
+	crumb := z.writeBack()
+	parent := Tree[T]{crumb.value, crumb.children}
+	return Some(TreeZipper[T]{parent, crumb.edited, z.crumbs.Tail()})
+}
+
+// Left focuses the previous sibling.
+func (z TreeZipper[T]) Left() Option[TreeZipper[T]] {
+	return z.sibling(-1)
+}
+
+// Right focuses the next sibling.
+func (z TreeZipper[T]) Right() Option[TreeZipper[T]] {
+	return z.sibling(1)
+}
+
+func (z TreeZipper[T]) sibling(step int) Option[TreeZipper[T]] {
+	if z.crumbs.IsEmpty() {
+		return None[TreeZipper[T]]()
+	}
+	i := z.crumbs.Head().index + step
+	if i < 0 || i >= len(z.crumbs.Head().children) {
+		return None[TreeZipper[T]]()
+	}
+	crumb := z.writeBack()
+	crumb.index = i
+	return Some(TreeZipper[T]{crumb.children[i], false, Cons(crumb, z.crumbs.Tail())})
+}
+
+// writeBack returns the innermost crumb with an edited focus stored among
+// its children.
+func (z TreeZipper[T]) writeBack() treeCrumb[T] {
+	crumb := z.crumbs.Head()
+	if z.edited {
+		crumb.children = slices.Clone(crumb.children)
+		crumb.children[crumb.index] = z.focus
+		crumb.edited = true
+	}
+	return crumb
+}
+
+// Modify applies fn to the focused value, keeping its children.
+func (z TreeZipper[T]) Modify(fn func(T) T) TreeZipper[T] {
+	return TreeZipper[T]{Tree[T]{fn(z.focus.Value), z.focus.Children}, true, z.crumbs}
+}
+
+// Replace swaps the whole focused subtree for t.
+func (z TreeZipper[T]) Replace(t Tree[T]) TreeZipper[T] {
+	return TreeZipper[T]{t, true, z.crumbs}
+}
+
+// Root returns the whole tree with every edit applied.
+func (z TreeZipper[T]) Root() Tree[T] {
+	for {
+		up, ok := z.Up().Get()
+		if !ok {
+			return z.focus
+		}
+		z = up
+	}
+}
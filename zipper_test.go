@@ -0,0 +1,137 @@
+package functionalgo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestListZipper(t *testing.T) {
+	if ListZipperFromSlice([]int{}).IsSome() {
+		t.Errorf("Expected None for an empty list")
+	}
+	z := ListZipperFromSlice([]int{1, 2, 3, 4}).Unwrap()
+	if z.Focus() != 1 || z.Index() != 0 || z.Len() != 4 || z.Left().IsSome() {
+		t.Errorf("Expected the focus on the first element")
+	}
+
+	t.Run("moves", func(t *testing.T) {
+		end := z.Right().Unwrap().Right().Unwrap().Right().Unwrap()
+		if end.Focus() != 4 || end.Index() != 3 || end.Right().IsSome() {
+			t.Errorf("Expected the focus on the last element, got %v", end.Focus())
+		}
+		if back := end.Left().Unwrap(); back.Focus() != 3 {
+			t.Errorf("Expected 3, got %v", back.Focus())
+		}
+		if !reflect.DeepEqual(end.ToSlice(), []int{1, 2, 3, 4}) {
+			t.Errorf("Expected moves to keep the list, got %v", end.ToSlice())
+		}
+	})
+
+	t.Run("edits", func(t *testing.T) {
+		at2 := z.Right().Unwrap()
+		for _, tc := range []struct {
+			name     string
+			result   ListZipper[int]
+			expected []int
+			focus    int
+		}{
+			{"modify", at2.Modify(func(x int) int { return x * 10 }), []int{1, 20, 3, 4}, 20},
+			{"set", at2.Set(9), []int{1, 9, 3, 4}, 9},
+			{"insert", at2.Insert(7), []int{1, 7, 2, 3, 4}, 7},
+			{"delete", at2.Delete().Unwrap(), []int{1, 3, 4}, 3},
+		} {
+			if !reflect.DeepEqual(tc.result.ToSlice(), tc.expected) || tc.result.Focus() != tc.focus {
+				t.Errorf("%s: expected %v with focus %d, got %v with focus %d", tc.name, tc.expected, tc.focus, tc.result.ToSlice(), tc.result.Focus())
+			}
+		}
+		if !reflect.DeepEqual(at2.ToSlice(), []int{1, 2, 3, 4}) {
+			t.Errorf("Expected edits to leave the original intact")
+		}
+	})
+
+	t.Run("delete at the end and of the only element", func(t *testing.T) {
+		last := ListZipperFromSlice([]int{1, 2}).Unwrap().Right().Unwrap().Delete().Unwrap()
+		if last.Focus() != 1 || !reflect.DeepEqual(last.ToSlice(), []int{1}) {
+			t.Errorf("Expected the focus to move back to 1, got %v", last.ToSlice())
+		}
+		if last.Delete().IsSome() {
+			t.Errorf("Expected None after deleting the only element")
+		}
+	})
+
+	t.Run("shares the tail after the focus", func(t *testing.T) {
+		l := ListOf(1, 2, 3)
+		if ListZipperFromList(l).Unwrap().ToList().Tail().node != l.Tail().node {
+			t.Errorf("Expected the rebuilt list to share the elements after the focus")
+		}
+	})
+}
+
+func TestTreeZipper(t *testing.T) {
+	tree := sampleTree()
+	z := TreeZipperFromTree(tree)
+	if !z.IsRoot() || z.Up().IsSome() || z.Left().IsSome() || z.Down(2).IsSome() || z.Down(-1).IsSome() {
+		t.Errorf("Expected the root to have no parent, siblings or third child")
+	}
+
+	t.Run("moves", func(t *testing.T) {
+		at5 := z.Down(0).Unwrap().Down(1).Unwrap()
+		if at5.Value() != 5 || at5.Depth() != 2 || at5.Right().IsSome() {
+			t.Errorf("Expected the focus on 5, got %v", at5.Value())
+		}
+		if at4 := at5.Left().Unwrap(); at4.Value() != 4 || at4.Left().IsSome() {
+			t.Errorf("Expected the focus on 4, got %v", at4.Value())
+		}
+		if at3 := at5.Up().Unwrap().Right().Unwrap(); at3.Value() != 3 {
+			t.Errorf("Expected the focus on 3, got %v", at3.Value())
+		}
+		if !reflect.DeepEqual(at5.Root(), tree) {
+			t.Errorf("Expected moves to keep the tree")
+		}
+	})
+
+	t.Run("edits", func(t *testing.T) {
+		edited := z.Down(1).Unwrap().
+			Modify(func(x int) int { return x * 10 }).
+			Down(0).Unwrap().
+			Replace(MkTree(60)).
+			Up().Unwrap().Left().Unwrap().
+			Down(0).Unwrap().Right().Unwrap().
+			Modify(func(x int) int { return -x }).
+			Root()
+		expected := MkTree(1,
+			MkTree(2, MkTree(4), MkTree(-5)),
+			MkTree(30, MkTree(60)),
+		)
+		if !reflect.DeepEqual(edited, expected) {
+			t.Errorf("Expected\n%s\ngot\n%s", DrawTree(expected), DrawTree(edited))
+		}
+		if !reflect.DeepEqual(tree, sampleTree()) {
+			t.Errorf("Expected edits to leave the original tree intact")
+		}
+	})
+
+	t.Run("edits survive sideways moves", func(t *testing.T) {
+		edited := z.Down(0).Unwrap().
+			Replace(MkTree(20)).
+			Right().Unwrap().Left().Unwrap().Right().Unwrap().
+			Modify(func(x int) int { return x + 1 }).
+			Left().Unwrap().
+			Root()
+		expected := MkTree(1, MkTree(20), MkTree(4, MkTree(6, MkTree(7))))
+		if !reflect.DeepEqual(edited, expected) {
+			t.Errorf("Expected\n%s\ngot\n%s", DrawTree(expected), DrawTree(edited))
+		}
+	})
+
+	t.Run("moves do not copy siblings", func(t *testing.T) {
+		wide := TreeZipperFromTree(MkTree(0, Map(func(i int) Tree[int] { return MkTree(i) }, Range(0, 1000, 1))...))
+		narrow := TreeZipperFromTree(MkTree(0, MkTree(1), MkTree(2), MkTree(3)))
+		moves := func(z TreeZipper[int]) func() {
+			return func() { z.Down(1).Unwrap().Right().Unwrap().Up().Unwrap() }
+		}
+		if w, n := testing.AllocsPerRun(10, moves(wide)), testing.AllocsPerRun(10, moves(narrow)); w != n {
+			t.Errorf("Expected moves in a wide node to allocate as in a narrow one, got %v and %v", w, n)
+		}
+	})
+}